@@ -19,6 +19,18 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CurrencyConverterClient interface {
 	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error)
+	GetRate(ctx context.Context, in *GetRateRequest, opts ...grpc.CallOption) (*GetRateResponse, error)
+	// Converts every item against the same snapshot of rates, fetched once.
+	BatchConvert(ctx context.Context, in *BatchConvertRequest, opts ...grpc.CallOption) (*BatchConvertResponse, error)
+	// Streams a snapshot of the requested pairs, then an update each time one of their rates changes.
+	SubscribeRates(ctx context.Context, in *SubscribeRatesRequest, opts ...grpc.CallOption) (CurrencyConverter_SubscribeRatesClient, error)
+	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
+	// Solves for the source amount needed to receive a target amount.
+	ConvertToTarget(ctx context.Context, in *ConvertToTargetRequest, opts ...grpc.CallOption) (*ConvertToTargetResponse, error)
+	// Prices a conversion and locks its rate until the quote expires.
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error)
+	// Converts at the quote's locked rate; each quote can be executed once.
+	ExecuteQuote(ctx context.Context, in *ExecuteQuoteRequest, opts ...grpc.CallOption) (*ExecuteQuoteResponse, error)
 }
 
 type currencyConverterClient struct {
@@ -38,11 +50,109 @@ func (c *currencyConverterClient) Convert(ctx context.Context, in *ConvertReques
 	return out, nil
 }
 
+func (c *currencyConverterClient) GetRate(ctx context.Context, in *GetRateRequest, opts ...grpc.CallOption) (*GetRateResponse, error) {
+	out := new(GetRateResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.CurrencyConverter/GetRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterClient) BatchConvert(ctx context.Context, in *BatchConvertRequest, opts ...grpc.CallOption) (*BatchConvertResponse, error) {
+	out := new(BatchConvertResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.CurrencyConverter/BatchConvert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterClient) SubscribeRates(ctx context.Context, in *SubscribeRatesRequest, opts ...grpc.CallOption) (CurrencyConverter_SubscribeRatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CurrencyConverter_ServiceDesc.Streams[0], "/currencyconverter.CurrencyConverter/SubscribeRates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &currencyConverterSubscribeRatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CurrencyConverter_SubscribeRatesClient interface {
+	Recv() (*SubscribeRatesResponse, error)
+	grpc.ClientStream
+}
+
+type currencyConverterSubscribeRatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *currencyConverterSubscribeRatesClient) Recv() (*SubscribeRatesResponse, error) {
+	m := new(SubscribeRatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *currencyConverterClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.CurrencyConverter/ListCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterClient) ConvertToTarget(ctx context.Context, in *ConvertToTargetRequest, opts ...grpc.CallOption) (*ConvertToTargetResponse, error) {
+	out := new(ConvertToTargetResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.CurrencyConverter/ConvertToTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error) {
+	out := new(CreateQuoteResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.CurrencyConverter/CreateQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyConverterClient) ExecuteQuote(ctx context.Context, in *ExecuteQuoteRequest, opts ...grpc.CallOption) (*ExecuteQuoteResponse, error) {
+	out := new(ExecuteQuoteResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.CurrencyConverter/ExecuteQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CurrencyConverterServer is the server API for CurrencyConverter service.
 // All implementations must embed UnimplementedCurrencyConverterServer
 // for forward compatibility
 type CurrencyConverterServer interface {
 	Convert(context.Context, *ConvertRequest) (*ConvertResponse, error)
+	GetRate(context.Context, *GetRateRequest) (*GetRateResponse, error)
+	// Converts every item against the same snapshot of rates, fetched once.
+	BatchConvert(context.Context, *BatchConvertRequest) (*BatchConvertResponse, error)
+	// Streams a snapshot of the requested pairs, then an update each time one of their rates changes.
+	SubscribeRates(*SubscribeRatesRequest, CurrencyConverter_SubscribeRatesServer) error
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	// Solves for the source amount needed to receive a target amount.
+	ConvertToTarget(context.Context, *ConvertToTargetRequest) (*ConvertToTargetResponse, error)
+	// Prices a conversion and locks its rate until the quote expires.
+	CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error)
+	// Converts at the quote's locked rate; each quote can be executed once.
+	ExecuteQuote(context.Context, *ExecuteQuoteRequest) (*ExecuteQuoteResponse, error)
 	mustEmbedUnimplementedCurrencyConverterServer()
 }
 
@@ -53,6 +163,27 @@ type UnimplementedCurrencyConverterServer struct {
 func (UnimplementedCurrencyConverterServer) Convert(context.Context, *ConvertRequest) (*ConvertResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
 }
+func (UnimplementedCurrencyConverterServer) GetRate(context.Context, *GetRateRequest) (*GetRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRate not implemented")
+}
+func (UnimplementedCurrencyConverterServer) BatchConvert(context.Context, *BatchConvertRequest) (*BatchConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchConvert not implemented")
+}
+func (UnimplementedCurrencyConverterServer) SubscribeRates(*SubscribeRatesRequest, CurrencyConverter_SubscribeRatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeRates not implemented")
+}
+func (UnimplementedCurrencyConverterServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedCurrencyConverterServer) ConvertToTarget(context.Context, *ConvertToTargetRequest) (*ConvertToTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertToTarget not implemented")
+}
+func (UnimplementedCurrencyConverterServer) CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedCurrencyConverterServer) ExecuteQuote(context.Context, *ExecuteQuoteRequest) (*ExecuteQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteQuote not implemented")
+}
 func (UnimplementedCurrencyConverterServer) mustEmbedUnimplementedCurrencyConverterServer() {}
 
 // UnsafeCurrencyConverterServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +215,135 @@ func _CurrencyConverter_Convert_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CurrencyConverter_GetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServer).GetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.CurrencyConverter/GetRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServer).GetRate(ctx, req.(*GetRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverter_BatchConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServer).BatchConvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.CurrencyConverter/BatchConvert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServer).BatchConvert(ctx, req.(*BatchConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverter_SubscribeRates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CurrencyConverterServer).SubscribeRates(m, &currencyConverterSubscribeRatesServer{stream})
+}
+
+type CurrencyConverter_SubscribeRatesServer interface {
+	Send(*SubscribeRatesResponse) error
+	grpc.ServerStream
+}
+
+type currencyConverterSubscribeRatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *currencyConverterSubscribeRatesServer) Send(m *SubscribeRatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CurrencyConverter_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.CurrencyConverter/ListCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServer).ListCurrencies(ctx, req.(*ListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverter_ConvertToTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertToTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServer).ConvertToTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.CurrencyConverter/ConvertToTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServer).ConvertToTarget(ctx, req.(*ConvertToTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverter_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.CurrencyConverter/CreateQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyConverter_ExecuteQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyConverterServer).ExecuteQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.CurrencyConverter/ExecuteQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyConverterServer).ExecuteQuote(ctx, req.(*ExecuteQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CurrencyConverter_ServiceDesc is the grpc.ServiceDesc for CurrencyConverter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,6 +355,320 @@ var CurrencyConverter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Convert",
 			Handler:    _CurrencyConverter_Convert_Handler,
 		},
+		{
+			MethodName: "GetRate",
+			Handler:    _CurrencyConverter_GetRate_Handler,
+		},
+		{
+			MethodName: "BatchConvert",
+			Handler:    _CurrencyConverter_BatchConvert_Handler,
+		},
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _CurrencyConverter_ListCurrencies_Handler,
+		},
+		{
+			MethodName: "ConvertToTarget",
+			Handler:    _CurrencyConverter_ConvertToTarget_Handler,
+		},
+		{
+			MethodName: "CreateQuote",
+			Handler:    _CurrencyConverter_CreateQuote_Handler,
+		},
+		{
+			MethodName: "ExecuteQuote",
+			Handler:    _CurrencyConverter_ExecuteQuote_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeRates",
+			Handler:       _CurrencyConverter_SubscribeRates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/currency_converter.proto",
+}
+
+// RateAdminClient is the client API for RateAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RateAdminClient interface {
+	UpsertRate(ctx context.Context, in *UpsertRateRequest, opts ...grpc.CallOption) (*UpsertRateResponse, error)
+	// Records that the rate is no longer available; its history is kept.
+	DeleteRate(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*DeleteRateResponse, error)
+	// Writes every rate in one transaction: if any is invalid, none is written.
+	BulkUpsertRates(ctx context.Context, in *BulkUpsertRatesRequest, opts ...grpc.CallOption) (*BulkUpsertRatesResponse, error)
+	ListRateHistory(ctx context.Context, in *ListRateHistoryRequest, opts ...grpc.CallOption) (*ListRateHistoryResponse, error)
+}
+
+type rateAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRateAdminClient(cc grpc.ClientConnInterface) RateAdminClient {
+	return &rateAdminClient{cc}
+}
+
+func (c *rateAdminClient) UpsertRate(ctx context.Context, in *UpsertRateRequest, opts ...grpc.CallOption) (*UpsertRateResponse, error) {
+	out := new(UpsertRateResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.RateAdmin/UpsertRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateAdminClient) DeleteRate(ctx context.Context, in *DeleteRateRequest, opts ...grpc.CallOption) (*DeleteRateResponse, error) {
+	out := new(DeleteRateResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.RateAdmin/DeleteRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateAdminClient) BulkUpsertRates(ctx context.Context, in *BulkUpsertRatesRequest, opts ...grpc.CallOption) (*BulkUpsertRatesResponse, error) {
+	out := new(BulkUpsertRatesResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.RateAdmin/BulkUpsertRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateAdminClient) ListRateHistory(ctx context.Context, in *ListRateHistoryRequest, opts ...grpc.CallOption) (*ListRateHistoryResponse, error) {
+	out := new(ListRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.RateAdmin/ListRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RateAdminServer is the server API for RateAdmin service.
+// All implementations must embed UnimplementedRateAdminServer
+// for forward compatibility
+type RateAdminServer interface {
+	UpsertRate(context.Context, *UpsertRateRequest) (*UpsertRateResponse, error)
+	// Records that the rate is no longer available; its history is kept.
+	DeleteRate(context.Context, *DeleteRateRequest) (*DeleteRateResponse, error)
+	// Writes every rate in one transaction: if any is invalid, none is written.
+	BulkUpsertRates(context.Context, *BulkUpsertRatesRequest) (*BulkUpsertRatesResponse, error)
+	ListRateHistory(context.Context, *ListRateHistoryRequest) (*ListRateHistoryResponse, error)
+	mustEmbedUnimplementedRateAdminServer()
+}
+
+// UnimplementedRateAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedRateAdminServer struct {
+}
+
+func (UnimplementedRateAdminServer) UpsertRate(context.Context, *UpsertRateRequest) (*UpsertRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertRate not implemented")
+}
+func (UnimplementedRateAdminServer) DeleteRate(context.Context, *DeleteRateRequest) (*DeleteRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRate not implemented")
+}
+func (UnimplementedRateAdminServer) BulkUpsertRates(context.Context, *BulkUpsertRatesRequest) (*BulkUpsertRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertRates not implemented")
+}
+func (UnimplementedRateAdminServer) ListRateHistory(context.Context, *ListRateHistoryRequest) (*ListRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRateHistory not implemented")
+}
+func (UnimplementedRateAdminServer) mustEmbedUnimplementedRateAdminServer() {}
+
+// UnsafeRateAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RateAdminServer will
+// result in compilation errors.
+type UnsafeRateAdminServer interface {
+	mustEmbedUnimplementedRateAdminServer()
+}
+
+func RegisterRateAdminServer(s grpc.ServiceRegistrar, srv RateAdminServer) {
+	s.RegisterService(&RateAdmin_ServiceDesc, srv)
+}
+
+func _RateAdmin_UpsertRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateAdminServer).UpsertRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.RateAdmin/UpsertRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateAdminServer).UpsertRate(ctx, req.(*UpsertRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateAdmin_DeleteRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateAdminServer).DeleteRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.RateAdmin/DeleteRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateAdminServer).DeleteRate(ctx, req.(*DeleteRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateAdmin_BulkUpsertRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateAdminServer).BulkUpsertRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.RateAdmin/BulkUpsertRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateAdminServer).BulkUpsertRates(ctx, req.(*BulkUpsertRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateAdmin_ListRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateAdminServer).ListRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.RateAdmin/ListRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateAdminServer).ListRateHistory(ctx, req.(*ListRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RateAdmin_ServiceDesc is the grpc.ServiceDesc for RateAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RateAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "currencyconverter.RateAdmin",
+	HandlerType: (*RateAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpsertRate",
+			Handler:    _RateAdmin_UpsertRate_Handler,
+		},
+		{
+			MethodName: "DeleteRate",
+			Handler:    _RateAdmin_DeleteRate_Handler,
+		},
+		{
+			MethodName: "BulkUpsertRates",
+			Handler:    _RateAdmin_BulkUpsertRates_Handler,
+		},
+		{
+			MethodName: "ListRateHistory",
+			Handler:    _RateAdmin_ListRateHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/currency_converter.proto",
+}
+
+// ConversionLedgerClient is the client API for ConversionLedger service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConversionLedgerClient interface {
+	ListConversions(ctx context.Context, in *ListConversionsRequest, opts ...grpc.CallOption) (*ListConversionsResponse, error)
+}
+
+type conversionLedgerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversionLedgerClient(cc grpc.ClientConnInterface) ConversionLedgerClient {
+	return &conversionLedgerClient{cc}
+}
+
+func (c *conversionLedgerClient) ListConversions(ctx context.Context, in *ListConversionsRequest, opts ...grpc.CallOption) (*ListConversionsResponse, error) {
+	out := new(ListConversionsResponse)
+	err := c.cc.Invoke(ctx, "/currencyconverter.ConversionLedger/ListConversions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversionLedgerServer is the server API for ConversionLedger service.
+// All implementations must embed UnimplementedConversionLedgerServer
+// for forward compatibility
+type ConversionLedgerServer interface {
+	ListConversions(context.Context, *ListConversionsRequest) (*ListConversionsResponse, error)
+	mustEmbedUnimplementedConversionLedgerServer()
+}
+
+// UnimplementedConversionLedgerServer must be embedded to have forward compatible implementations.
+type UnimplementedConversionLedgerServer struct {
+}
+
+func (UnimplementedConversionLedgerServer) ListConversions(context.Context, *ListConversionsRequest) (*ListConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversions not implemented")
+}
+func (UnimplementedConversionLedgerServer) mustEmbedUnimplementedConversionLedgerServer() {}
+
+// UnsafeConversionLedgerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversionLedgerServer will
+// result in compilation errors.
+type UnsafeConversionLedgerServer interface {
+	mustEmbedUnimplementedConversionLedgerServer()
+}
+
+func RegisterConversionLedgerServer(s grpc.ServiceRegistrar, srv ConversionLedgerServer) {
+	s.RegisterService(&ConversionLedger_ServiceDesc, srv)
+}
+
+func _ConversionLedger_ListConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversionLedgerServer).ListConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencyconverter.ConversionLedger/ListConversions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversionLedgerServer).ListConversions(ctx, req.(*ListConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConversionLedger_ServiceDesc is the grpc.ServiceDesc for ConversionLedger service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConversionLedger_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "currencyconverter.ConversionLedger",
+	HandlerType: (*ConversionLedgerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListConversions",
+			Handler:    _ConversionLedger_ListConversions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/currency_converter.proto",
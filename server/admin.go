@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// maxBulkRates caps the number of rates in a single BulkUpsertRates call
+const maxBulkRates = 1000
+
+// adminServer implements the RateAdmin service on top of a writable rate store
+type adminServer struct {
+	pb.UnimplementedRateAdminServer
+	store RateWriter
+}
+
+func newAdminServer(store RateWriter) *adminServer {
+	return &adminServer{store: store}
+}
+
+// UpsertRate implements the gRPC method recording a new value for a rate
+func (a *adminServer) UpsertRate(ctx context.Context, req *pb.UpsertRateRequest) (*pb.UpsertRateResponse, error) {
+	caller, err := requireCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, violations := upsertChange(req, a.store.Base(), "")
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations...)
+	}
+	change.RecordedBy = caller
+
+	stored, err := a.write(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpsertRateResponse{Change: rateChangeProto(stored[0])}, nil
+}
+
+// DeleteRate implements the gRPC method making a rate unavailable
+func (a *adminServer) DeleteRate(ctx context.Context, req *pb.DeleteRateRequest) (*pb.DeleteRateResponse, error) {
+	caller, err := requireCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := validateDeleteRateRequest(req, a.store.Base())
+	if err != nil {
+		return nil, err
+	}
+	change.RecordedBy = caller
+
+	latest, err := a.store.RateHistory(ctx, change.Base, change.Quote, time.Time{}, 1)
+	if err != nil {
+		log.Printf("Error retrieving rate history for %s/%s: %v", change.Base, change.Quote, err)
+		return nil, rateLookupError(change.Base+"/"+change.Quote, err)
+	}
+	if len(latest) == 0 || latest[0].Deleted {
+		return nil, rateLookupError(change.Base+"/"+change.Quote, ErrRateNotFound)
+	}
+
+	stored, err := a.write(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteRateResponse{Change: rateChangeProto(stored[0])}, nil
+}
+
+// BulkUpsertRates implements the gRPC method recording many rates at once, all or none
+func (a *adminServer) BulkUpsertRates(ctx context.Context, req *pb.BulkUpsertRatesRequest) (*pb.BulkUpsertRatesResponse, error) {
+	caller, err := requireCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetRates()) == 0 {
+		return nil, invalidArgumentError(fieldViolation("rates", errors.New("at least one rate is required")))
+	}
+	if len(req.GetRates()) > maxBulkRates {
+		return nil, invalidArgumentError(fieldViolation("rates", fmt.Errorf("at most %d rates are allowed", maxBulkRates)))
+	}
+
+	changes := make([]RateChange, len(req.GetRates()))
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, r := range req.GetRates() {
+		change, invalid := upsertChange(r, a.store.Base(), fmt.Sprintf("rates[%d].", i))
+		violations = append(violations, invalid...)
+		change.RecordedBy = caller
+		changes[i] = change
+	}
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations...)
+	}
+
+	stored, err := a.write(ctx, changes...)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BulkUpsertRatesResponse{Changes: make([]*pb.RateChange, len(stored))}
+	for i, c := range stored {
+		res.Changes[i] = rateChangeProto(c)
+	}
+	return res, nil
+}
+
+// ListRateHistory implements the gRPC method listing every change of a rate, newest first
+func (a *adminServer) ListRateHistory(ctx context.Context, req *pb.ListRateHistoryRequest) (*pb.ListRateHistoryResponse, error) {
+	if _, err := requireCaller(ctx); err != nil {
+		return nil, err
+	}
+	params, err := validateListRateHistoryRequest(req, a.store.Base())
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra change to find out whether there is another page
+	changes, err := a.store.RateHistory(ctx, params.base, params.quote, params.before, params.pageSize+1)
+	if err != nil {
+		log.Printf("Error retrieving rate history for %s/%s: %v", params.base, params.quote, err)
+		return nil, rateLookupError(params.base+"/"+params.quote, err)
+	}
+
+	res := &pb.ListRateHistoryResponse{}
+	if len(changes) > params.pageSize {
+		changes = changes[:params.pageSize]
+		res.NextPageToken = encodePageToken(changes[len(changes)-1].EffectiveFrom.Format(time.RFC3339Nano))
+	}
+	for _, c := range changes {
+		res.Changes = append(res.Changes, rateChangeProto(c))
+	}
+	return res, nil
+}
+
+// write records the changes and logs them for auditing
+func (a *adminServer) write(ctx context.Context, changes ...RateChange) ([]RateChange, error) {
+	stored, err := a.store.WriteRates(ctx, changes)
+	if err != nil {
+		log.Printf("Error recording rate changes: %v", err)
+		return nil, rateWriteError(err)
+	}
+	for _, c := range stored {
+		if c.Deleted {
+			log.Printf("rate %s/%s deleted from %s by %s", c.Base, c.Quote, c.EffectiveFrom.Format(time.RFC3339), c.RecordedBy)
+		} else {
+			log.Printf("rate %s/%s set to %s from %s by %s", c.Base, c.Quote, c.Value, c.EffectiveFrom.Format(time.RFC3339), c.RecordedBy)
+		}
+	}
+	return stored, nil
+}
+
+// requireCaller returns the caller identity, rejecting anonymous callers since every change is attributed
+func requireCaller(ctx context.Context) (string, error) {
+	caller := callerIdentity(ctx)
+	if caller == "" {
+		return "", status.Errorf(codes.Unauthenticated, "the %s metadata must identify the caller", clientIDHeader)
+	}
+	return caller, nil
+}
+
+// rateChangeProto describes a stored rate change for a response
+func rateChangeProto(c RateChange) *pb.RateChange {
+	res := &pb.RateChange{
+		BaseCurrency:  c.Base,
+		QuoteCurrency: c.Quote,
+		EffectiveFrom: timestamppb.New(c.EffectiveFrom),
+		Provider:      c.Provider,
+		Deleted:       c.Deleted,
+		RecordedBy:    c.RecordedBy,
+		RecordedAt:    timestamppb.New(c.RecordedAt),
+	}
+	if !c.Deleted {
+		res.Rate = c.Value.String()
+	}
+	if !c.Bid.IsZero() {
+		res.Bid = c.Bid.String()
+	}
+	if !c.Ask.IsZero() {
+		res.Ask = c.Ask.String()
+	}
+	return res
+}
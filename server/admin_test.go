@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+func adminContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(clientIDHeader, "treasury-ops"))
+}
+
+func TestUpsertRate(t *testing.T) {
+	s := newTestServer()
+	admin := newAdminServer(s.store.(*memoryStore))
+	ctx := adminContext(t)
+
+	res, err := admin.UpsertRate(ctx, &pb.UpsertRateRequest{BaseCurrency: "GBP", Rate: "105.25", Provider: "rbi"})
+	assert.NoError(t, err)
+	assert.Equal(t, "GBP", res.Change.BaseCurrency)
+	assert.Equal(t, "INR", res.Change.QuoteCurrency)
+	assert.Equal(t, "105.25", res.Change.Rate)
+	assert.Equal(t, "treasury-ops", res.Change.RecordedBy)
+	assert.Equal(t, "rbi", res.Change.Provider)
+	assert.NotNil(t, res.Change.EffectiveFrom)
+
+	converted, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 2, SourceCurrency: "GBP", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, "210.5", converted.UnroundedAmount)
+}
+
+func TestUpsertRateValidation(t *testing.T) {
+	admin := newAdminServer(newTestServer().store.(*memoryStore))
+	ctx := adminContext(t)
+
+	for _, req := range []*pb.UpsertRateRequest{
+		{BaseCurrency: "USD", Rate: "-1"},
+		{BaseCurrency: "USD", Rate: "0"},
+		{BaseCurrency: "USD", Rate: "NaN"},
+		{BaseCurrency: "USD", Rate: "Infinity"},
+		{BaseCurrency: "USD", Rate: "1e12"},
+		{BaseCurrency: "USD", Rate: "0.0000000000001"},
+		{BaseCurrency: "XYZ", Rate: "1"},
+		{BaseCurrency: "USD", QuoteCurrency: "USD", Rate: "1"},
+	} {
+		_, err := admin.UpsertRate(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	_, err := admin.UpsertRate(context.Background(), &pb.UpsertRateRequest{BaseCurrency: "USD", Rate: "80"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestBulkUpsertRatesIsAllOrNothing(t *testing.T) {
+	store := newTestServer().store.(*memoryStore)
+	admin := newAdminServer(store)
+	ctx := adminContext(t)
+
+	_, err := admin.BulkUpsertRates(ctx, &pb.BulkUpsertRatesRequest{Rates: []*pb.UpsertRateRequest{
+		{BaseCurrency: "GBP", Rate: "105"},
+		{BaseCurrency: "CHF", Rate: "-95"},
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []string{"rates[1].rate"}, violatedFields(t, err))
+	_, err = store.Rate(ctx, "GBP", time.Time{})
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	res, err := admin.BulkUpsertRates(ctx, &pb.BulkUpsertRatesRequest{Rates: []*pb.UpsertRateRequest{
+		{BaseCurrency: "GBP", Rate: "105"},
+		{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.09"},
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, res.Changes, 2)
+	pair, err := store.Pair(ctx, "USD", "EUR", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.09", pair.Value.String())
+}
+
+func TestDeleteRate(t *testing.T) {
+	s := newTestServer()
+	admin := newAdminServer(s.store.(*memoryStore))
+	ctx := adminContext(t)
+	before := time.Now()
+
+	res, err := admin.DeleteRate(ctx, &pb.DeleteRateRequest{BaseCurrency: "JPY"})
+	assert.NoError(t, err)
+	assert.True(t, res.Change.Deleted)
+	assert.Empty(t, res.Change.Rate)
+
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 1, SourceCurrency: "JPY", TargetCurrency: "INR"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	// The deleted rate is still used for conversions as of an earlier instant
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 1, SourceCurrency: "JPY", TargetCurrency: "INR", AsOf: timestamppb.New(before)})
+	assert.NoError(t, err)
+
+	_, err = admin.DeleteRate(ctx, &pb.DeleteRateRequest{BaseCurrency: "JPY"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = admin.DeleteRate(ctx, &pb.DeleteRateRequest{BaseCurrency: "GBP"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListRateHistory(t *testing.T) {
+	admin := newAdminServer(newTestServer().store.(*memoryStore))
+	ctx := adminContext(t)
+	// Scheduled changes, as history can only be added to from now on
+	start := time.Now().Add(time.Hour).UTC()
+	for i, rate := range []string{"83.20", "83.30", "83.40"} {
+		_, err := admin.UpsertRate(ctx, &pb.UpsertRateRequest{
+			BaseCurrency:  "USD",
+			Rate:          rate,
+			EffectiveFrom: timestamppb.New(start.Add(time.Duration(i) * time.Minute)),
+		})
+		assert.NoError(t, err)
+	}
+
+	res, err := admin.ListRateHistory(ctx, &pb.ListRateHistoryRequest{BaseCurrency: "USD", PageSize: 2})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Changes, 2) {
+		assert.Equal(t, "83.4", res.Changes[0].Rate)
+		assert.Equal(t, "83.3", res.Changes[1].Rate)
+	}
+	assert.NotEmpty(t, res.NextPageToken)
+
+	res, err = admin.ListRateHistory(ctx, &pb.ListRateHistoryRequest{BaseCurrency: "USD", PageSize: 2, PageToken: res.NextPageToken})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Changes, 2) {
+		assert.Equal(t, "83.2", res.Changes[0].Rate)
+		// The seeded rate has no recorded caller
+		assert.Equal(t, "83.12", res.Changes[1].Rate)
+		assert.Empty(t, res.Changes[1].RecordedBy)
+	}
+	assert.Empty(t, res.NextPageToken)
+}
+
+func TestUpsertRateBidAsk(t *testing.T) {
+	s := newTestServer()
+	admin := newAdminServer(s.store.(*memoryStore))
+	ctx := adminContext(t)
+
+	res, err := admin.UpsertRate(ctx, &pb.UpsertRateRequest{BaseCurrency: "USD", Rate: "83.12", Bid: "83.00", Ask: "83.24"})
+	assert.NoError(t, err)
+	assert.Equal(t, "83", res.Change.Bid)
+	assert.Equal(t, "83.24", res.Change.Ask)
+
+	converted, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_SELL})
+	assert.NoError(t, err)
+	assert.Equal(t, "8324", converted.UnroundedAmount)
+
+	for _, req := range []*pb.UpsertRateRequest{
+		{BaseCurrency: "USD", Rate: "83.12", Bid: "83.20"},
+		{BaseCurrency: "USD", Rate: "83.12", Ask: "83.00"},
+		{BaseCurrency: "USD", Rate: "83.12", Bid: "-1"},
+		{BaseCurrency: "USD", Rate: "83.12", Ask: "abc"},
+	} {
+		_, err := admin.UpsertRate(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
+
+func TestRateHistoryIsAppendOnly(t *testing.T) {
+	s := newTestServer()
+	admin := newAdminServer(s.store.(*memoryStore))
+	ctx := adminContext(t)
+	hourAgo := time.Now().Add(-time.Hour)
+
+	// Backdated changes would alter conversions already made
+	_, err := admin.UpsertRate(ctx, &pb.UpsertRateRequest{BaseCurrency: "USD", Rate: "1", EffectiveFrom: timestamppb.New(time.Unix(0, 0))})
+	assert.Equal(t, []string{"effective_from"}, violatedFields(t, err))
+	_, err = admin.DeleteRate(ctx, &pb.DeleteRateRequest{BaseCurrency: "USD", EffectiveFrom: timestamppb.New(hourAgo)})
+	assert.Equal(t, []string{"effective_from"}, violatedFields(t, err))
+	converted, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", AsOf: timestamppb.New(hourAgo)})
+	assert.NoError(t, err)
+	assert.Equal(t, "8312", converted.UnroundedAmount)
+
+	// A scheduled change is never replaced, even in an otherwise valid batch
+	at := timestamppb.New(time.Now().Add(time.Hour))
+	_, err = admin.UpsertRate(ctx, &pb.UpsertRateRequest{BaseCurrency: "USD", Rate: "84", EffectiveFrom: at})
+	assert.NoError(t, err)
+	_, err = admin.BulkUpsertRates(ctx, &pb.BulkUpsertRatesRequest{Rates: []*pb.UpsertRateRequest{
+		{BaseCurrency: "GBP", Rate: "105"},
+		{BaseCurrency: "USD", Rate: "85", EffectiveFrom: at},
+	}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = s.store.Rate(ctx, "GBP", time.Time{})
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	res, err := admin.ListRateHistory(ctx, &pb.ListRateHistoryRequest{BaseCurrency: "USD"})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Changes, 2) {
+		assert.Equal(t, "84", res.Changes[0].Rate)
+		assert.Equal(t, "treasury-ops", res.Changes[0].RecordedBy)
+	}
+}
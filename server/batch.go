@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+// maxBatchItems caps the number of conversions in a single BatchConvert call
+const maxBatchItems = 1000
+
+// BatchConvert implements the gRPC method converting many amounts against one snapshot of rates
+func (s *server) BatchConvert(ctx context.Context, req *pb.BatchConvertRequest) (*pb.BatchConvertResponse, error) {
+	items := req.GetItems()
+	if len(items) == 0 {
+		return nil, invalidArgumentError(fieldViolation("items", errors.New("at least one item is required")))
+	}
+	if len(items) > maxBatchItems {
+		return nil, invalidArgumentError(fieldViolation("items", fmt.Errorf("at most %d items are allowed", maxBatchItems)))
+	}
+	asOf, err := requestAsOf(req.GetAsOf())
+	if err != nil {
+		return nil, invalidArgumentError(fieldViolation("as_of", err))
+	}
+
+	// Fetch every rate once so all items are priced against the same snapshot
+	snapshot, err := takeSnapshot(ctx, s.store, asOf)
+	if err != nil {
+		log.Printf("Error retrieving rate snapshot: %v", err)
+		return nil, rateLookupError("batch", err)
+	}
+	batch := s.withStore(snapshot)
+
+	res := &pb.BatchConvertResponse{Results: make([]*pb.BatchConvertResult, len(items))}
+	for i, item := range items {
+		// Items are converted directly rather than through Convert, which would apply the
+		// call's idempotency key to every item
+		params, err := batch.policy.validateConvertRequest(&pb.ConvertRequest{
+			Amount:         item.GetAmount(),
+			SourceCurrency: item.GetSourceCurrency(),
+			TargetCurrency: item.GetTargetCurrency(),
+			AmountMoney:    item.GetAmountMoney(),
+			RoundingMode:   item.GetRoundingMode(),
+			Side:           item.GetSide(),
+			AsOf:           req.GetAsOf(),
+		})
+		var converted *pb.ConvertResponse
+		if err == nil {
+			converted, _, err = batch.convert(ctx, params)
+		}
+		if err != nil {
+			res.Results[i] = &pb.BatchConvertResult{Result: &pb.BatchConvertResult_Error{Error: status.Convert(err).Proto()}}
+			continue
+		}
+		res.Results[i] = &pb.BatchConvertResult{Result: &pb.BatchConvertResult_Response{Response: converted}}
+	}
+	return res, nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+// countingStore records how the wrapped store is queried
+type countingStore struct {
+	RateStore
+	allRates, lookups int
+}
+
+func (c *countingStore) Rate(ctx context.Context, currency string, asOf time.Time) (Rate, error) {
+	c.lookups++
+	return c.RateStore.Rate(ctx, currency, asOf)
+}
+
+func (c *countingStore) Pair(ctx context.Context, source, target string, asOf time.Time) (Rate, error) {
+	c.lookups++
+	return c.RateStore.Pair(ctx, source, target, asOf)
+}
+
+func (c *countingStore) AllRates(ctx context.Context, asOf time.Time) ([]Rate, error) {
+	c.allRates++
+	return c.RateStore.AllRates(ctx, asOf)
+}
+
+func TestBatchConvertUsesOneSnapshot(t *testing.T) {
+	store := &countingStore{RateStore: newTestServer().store}
+	s := newServer(store)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.BatchConvert(ctx, &pb.BatchConvertRequest{Items: []*pb.ConvertItem{
+		{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"},
+		{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "USD"},
+		{Amount: 100, SourceCurrency: "JPY", TargetCurrency: "KWD"},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, store.allRates)
+	assert.Equal(t, 0, store.lookups)
+	if assert.Len(t, res.Results, 3) {
+		assert.Equal(t, int64(8312), res.Results[0].GetResponse().GetConvertedMoney().GetUnits())
+		assert.Equal(t, "108.818575553416746872", res.Results[1].GetResponse().GetUnroundedAmount())
+		assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_PIVOT, res.Results[2].GetResponse().GetRoute())
+	}
+}
+
+func TestBatchConvertMatchesConvert(t *testing.T) {
+	s := newTestServer()
+	s.store.(*memoryStore).SetPair("EUR", "USD", decimal.RequireFromString("1.0850"))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	item := &pb.ConvertItem{Amount: 250, SourceCurrency: "USD", TargetCurrency: "EUR", RoundingMode: pb.RoundingMode_ROUNDING_MODE_DOWN}
+	single, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         item.Amount,
+		SourceCurrency: item.SourceCurrency,
+		TargetCurrency: item.TargetCurrency,
+		RoundingMode:   item.RoundingMode,
+	})
+	assert.NoError(t, err)
+
+	res, err := s.BatchConvert(ctx, &pb.BatchConvertRequest{Items: []*pb.ConvertItem{item}})
+	assert.NoError(t, err)
+	batched := res.Results[0].GetResponse()
+	assert.Equal(t, single.ConvertedMoney.String(), batched.GetConvertedMoney().String())
+	assert.Equal(t, single.UnroundedAmount, batched.GetUnroundedAmount())
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_DIRECT, batched.GetRoute())
+}
+
+func TestBatchConvertItemErrors(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.BatchConvert(ctx, &pb.BatchConvertRequest{Items: []*pb.ConvertItem{
+		{Amount: 10, SourceCurrency: "USD", TargetCurrency: "XYZ"},
+		{Amount: 10, SourceCurrency: "USD", TargetCurrency: "GBP"},
+		{Amount: 10, SourceCurrency: "USD", TargetCurrency: "EUR"},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.InvalidArgument), res.Results[0].GetError().GetCode())
+	assert.Equal(t, int32(codes.NotFound), res.Results[1].GetError().GetCode())
+	assert.Nil(t, res.Results[2].GetError())
+	assert.NotNil(t, res.Results[2].GetResponse())
+}
+
+func TestBatchConvertInvalidRequest(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.BatchConvert(ctx, &pb.BatchConvertRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	items := make([]*pb.ConvertItem, maxBatchItems+1)
+	for i := range items {
+		items[i] = &pb.ConvertItem{Amount: 1, SourceCurrency: "USD", TargetCurrency: "INR"}
+	}
+	_, err = s.BatchConvert(ctx, &pb.BatchConvertRequest{Items: items})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// cacheRefreshTimeout bounds a background refresh, which has no request deadline to inherit
+const cacheRefreshTimeout = 10 * time.Second
+
+// cacheOptions controls the in-process rate cache
+type cacheOptions struct {
+	// TTL is how long a snapshot of the rates is served before it is refreshed
+	TTL time.Duration
+	// StaleWhileRevalidate is how long past its TTL a snapshot is still served while a single
+	// background refresh replaces it. Lookups after that wait for a refresh.
+	StaleWhileRevalidate time.Duration
+}
+
+func (o cacheOptions) validate() error {
+	if o.TTL < 0 || o.StaleWhileRevalidate < 0 {
+		return errors.New("ttl and stale_while_revalidate must not be negative")
+	}
+	if o.TTL == 0 && o.StaleWhileRevalidate > 0 {
+		return errors.New("stale_while_revalidate requires a ttl")
+	}
+	return nil
+}
+
+// cachedSnapshot is an immutable copy of the rate table and when it was read
+type cachedSnapshot struct {
+	rates   *snapshotStore
+	takenAt time.Time
+}
+
+// cachedStore serves current rates from a snapshot of the whole rate table, swapped atomically on
+// refresh, so lookups neither query the database nor take a lock while it is fresh. Lookups of
+// rates as of an earlier instant go to the underlying store.
+type cachedStore struct {
+	RateStore
+	opts cacheOptions
+	now  func() time.Time
+
+	snapshot   atomic.Pointer[cachedSnapshot]
+	revalidate atomic.Bool
+	// refreshMu lets only one caller at a time query the underlying store for an expired snapshot
+	refreshMu sync.Mutex
+}
+
+func newCachedStore(store RateStore, opts cacheOptions) *cachedStore {
+	return &cachedStore{RateStore: store, opts: opts, now: time.Now}
+}
+
+// current returns the snapshot to serve, refreshing it first if it is missing or too old
+func (c *cachedStore) current(ctx context.Context) (*snapshotStore, error) {
+	if snap := c.snapshot.Load(); snap != nil {
+		age := c.now().Sub(snap.takenAt)
+		if age < c.opts.TTL {
+			return snap.rates, nil
+		}
+		if age < c.opts.TTL+c.opts.StaleWhileRevalidate {
+			c.revalidateInBackground()
+			return snap.rates, nil
+		}
+	}
+	return c.refresh(ctx)
+}
+
+// refresh replaces an expired snapshot. Callers that waited for another caller's refresh use its snapshot.
+func (c *cachedStore) refresh(ctx context.Context) (*snapshotStore, error) {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if snap := c.snapshot.Load(); snap != nil && c.now().Sub(snap.takenAt) < c.opts.TTL {
+		return snap.rates, nil
+	}
+	takenAt := c.now()
+	rates, err := takeSnapshot(ctx, c.RateStore, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	c.snapshot.Store(&cachedSnapshot{rates: rates, takenAt: takenAt})
+	return rates, nil
+}
+
+// revalidateInBackground starts a refresh unless one is already running
+func (c *cachedStore) revalidateInBackground() {
+	if !c.revalidate.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer c.revalidate.Store(false)
+		ctx, cancel := context.WithTimeout(context.Background(), cacheRefreshTimeout)
+		defer cancel()
+		if _, err := c.refresh(ctx); err != nil {
+			log.Printf("Error refreshing rate cache: %v", err)
+		}
+	}()
+}
+
+func (c *cachedStore) Rate(ctx context.Context, currency string, asOf time.Time) (Rate, error) {
+	if !asOf.IsZero() {
+		return c.RateStore.Rate(ctx, currency, asOf)
+	}
+	rates, err := c.current(ctx)
+	if err != nil {
+		return Rate{}, err
+	}
+	return rates.Rate(ctx, currency, asOf)
+}
+
+func (c *cachedStore) Pair(ctx context.Context, source, target string, asOf time.Time) (Rate, error) {
+	if !asOf.IsZero() {
+		return c.RateStore.Pair(ctx, source, target, asOf)
+	}
+	rates, err := c.current(ctx)
+	if err != nil {
+		return Rate{}, err
+	}
+	return rates.Pair(ctx, source, target, asOf)
+}
+
+func (c *cachedStore) AllRates(ctx context.Context, asOf time.Time) ([]Rate, error) {
+	if !asOf.IsZero() {
+		return c.RateStore.AllRates(ctx, asOf)
+	}
+	rates, err := c.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rates.AllRates(ctx, asOf)
+}
+
+func (c *cachedStore) RateGraph(ctx context.Context, asOf time.Time) (*rateGraph, error) {
+	if !asOf.IsZero() {
+		return storeRateGraph(ctx, c.RateStore, asOf)
+	}
+	rates, err := c.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rates.RateGraph(ctx, asOf)
+}
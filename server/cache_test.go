@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	pb "CurrencyConverter/proto"
+)
+
+// testClock is a clock tests move by hand
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// newCachedTestServer returns a server whose rates are cached in front of a counting memory store
+func newCachedTestServer(opts cacheOptions) (*server, *countingStore, *testClock) {
+	store := &countingStore{RateStore: newTestServer().store}
+	clock := &testClock{now: time.Now()}
+	cache := newCachedStore(store, opts)
+	cache.now = clock.Now
+	return newServer(cache), store, clock
+}
+
+func convertUSDToINR(t *testing.T, s *server) string {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	return res.GetUnroundedAmount()
+}
+
+func TestCachedStoreServesSnapshotUntilTTL(t *testing.T) {
+	s, store, clock := newCachedTestServer(cacheOptions{TTL: time.Minute})
+	memory := store.RateStore.(*memoryStore)
+
+	assert.Equal(t, "8312", convertUSDToINR(t, s))
+	memory.Set("USD", decimal.RequireFromString("84"))
+	clock.Advance(59 * time.Second)
+	assert.Equal(t, "8312", convertUSDToINR(t, s))
+	assert.Equal(t, 1, store.allRates)
+	assert.Equal(t, 0, store.lookups)
+
+	// Once expired, the next lookup waits for fresh rates
+	clock.Advance(time.Second)
+	assert.Equal(t, "8400", convertUSDToINR(t, s))
+	assert.Equal(t, 2, store.allRates)
+}
+
+func TestCachedStoreStaleWhileRevalidate(t *testing.T) {
+	s, store, clock := newCachedTestServer(cacheOptions{TTL: time.Minute, StaleWhileRevalidate: time.Minute})
+	memory := store.RateStore.(*memoryStore)
+	cache := s.store.(*cachedStore)
+
+	assert.Equal(t, "8312", convertUSDToINR(t, s))
+	memory.Set("USD", decimal.RequireFromString("84"))
+
+	// An expired snapshot is still served while it is refreshed in the background
+	clock.Advance(90 * time.Second)
+	assert.Equal(t, "8312", convertUSDToINR(t, s))
+	assert.Eventually(t, func() bool {
+		return !cache.revalidate.Load() && cache.snapshot.Load().takenAt.Equal(clock.Now())
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, "8400", convertUSDToINR(t, s))
+
+	// Past the stale window the lookup waits for a refresh
+	memory.Set("USD", decimal.RequireFromString("85"))
+	clock.Advance(2 * time.Minute)
+	assert.Equal(t, "8500", convertUSDToINR(t, s))
+}
+
+func TestCachedStoreHistoricalLookupsBypassCache(t *testing.T) {
+	s, store, _ := newCachedTestServer(cacheOptions{TTL: time.Minute})
+	lastMonth := time.Now().AddDate(0, -1, 0)
+	store.RateStore.(*memoryStore).SetAt("USD", decimal.RequireFromString("80"), lastMonth)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	rate, err := s.store.Rate(ctx, "USD", lastMonth.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, "80", rate.Value.String())
+	assert.Equal(t, 1, store.lookups)
+	assert.Equal(t, 0, store.allRates)
+}
+
+func TestCachedStoreConcurrentLookups(t *testing.T) {
+	s, store, clock := newCachedTestServer(cacheOptions{TTL: time.Minute, StaleWhileRevalidate: time.Minute})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				convertUSDToINR(t, s)
+			}
+		}()
+	}
+	wg.Wait()
+	// The first lookups waited for the same refresh
+	assert.Equal(t, 1, store.allRates)
+
+	clock.Advance(90 * time.Second)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "8312", convertUSDToINR(t, s))
+		}()
+	}
+	wg.Wait()
+	assert.Eventually(t, func() bool { return !s.store.(*cachedStore).revalidate.Load() }, time.Second, time.Millisecond)
+	// Stale lookups started a single background refresh
+	assert.Equal(t, 2, store.allRates)
+}
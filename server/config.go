@@ -0,0 +1,455 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the service configuration. Values are resolved in increasing order of
+// precedence: built-in defaults, the YAML config file, environment variables, then flags.
+type Config struct {
+	ListenAddress   string        `yaml:"listen_address"`
+	RequestTimeout  time.Duration `yaml:"request_timeout"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// BaseCurrency is the currency the conversion_rates dataset is quoted against
+	BaseCurrency string            `yaml:"base_currency"`
+	Database     DatabaseConfig    `yaml:"database"`
+	Routing      RoutingConfig     `yaml:"routing"`
+	Features     FeatureConfig     `yaml:"features"`
+	Streaming    StreamConfig      `yaml:"streaming"`
+	Admin        AdminConfig       `yaml:"admin"`
+	Quotes       QuoteConfig       `yaml:"quotes"`
+	Fees         FeeConfig         `yaml:"fees"`
+	Idempotency  IdempotencyConfig `yaml:"idempotency"`
+	Cache        CacheConfig       `yaml:"cache"`
+}
+
+// DatabaseConfig describes the PostgreSQL connection and pool
+type DatabaseConfig struct {
+	// DSN overrides the individual connection fields when set
+	DSN          string `yaml:"dsn"`
+	Host         string `yaml:"host"`
+	Port         int    `yaml:"port"`
+	User         string `yaml:"user"`
+	Password     string `yaml:"password"`
+	PasswordFile string `yaml:"password_file"`
+	Name         string `yaml:"name"`
+	SSLMode      string `yaml:"sslmode"`
+
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnectTimeout  time.Duration `yaml:"connect_timeout"`
+}
+
+// RoutingConfig controls how cross conversions are found when no direct or pivot route exists
+type RoutingConfig struct {
+	// PathStrategy is fewest_hops or best_rate
+	PathStrategy string `yaml:"path_strategy"`
+	MaxHops      int    `yaml:"max_hops"`
+}
+
+// StreamConfig controls SubscribeRates streams
+type StreamConfig struct {
+	PollInterval      time.Duration `yaml:"poll_interval"`
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval"`
+	// BufferSize is the number of pending updates kept for a slow subscriber
+	BufferSize int `yaml:"buffer_size"`
+}
+
+// AdminConfig controls the RateAdmin and ConversionLedger services
+type AdminConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// ListenAddress serves the admin services on their own port; empty shares the main listener
+	ListenAddress string `yaml:"listen_address"`
+	// TrustClientID acknowledges that rate changes are attributed to the caller's x-client-id, which
+	// is whatever it sends, so the admin services must only be reachable by authenticated callers
+	TrustClientID bool `yaml:"trust_client_id"`
+}
+
+// QuoteConfig controls CreateQuote
+type QuoteConfig struct {
+	// TTL is how long a quote's rate stays locked
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// IdempotencyConfig controls idempotent Convert calls
+type IdempotencyConfig struct {
+	// Window is how long the first response to an idempotency key is returned for retries
+	Window time.Duration `yaml:"window"`
+}
+
+// CacheConfig controls the in-process cache of current rates
+type CacheConfig struct {
+	// TTL is how long cached rates are served before they are re-read; 0 disables the cache
+	TTL time.Duration `yaml:"ttl"`
+	// StaleWhileRevalidate is how long past the TTL cached rates are still served while they are re-read
+	StaleWhileRevalidate time.Duration `yaml:"stale_while_revalidate"`
+}
+
+// FeeConfig holds the fee rules charged on conversions. Rules are only read from the config file.
+type FeeConfig struct {
+	Rules feeSchedule `yaml:"rules"`
+	// TrustClientID allows rules for a client. x-client-id is whatever the caller sends, so it must
+	// only be set behind a proxy that authenticates callers and sets or strips the header.
+	TrustClientID bool `yaml:"trust_client_id"`
+}
+
+// FeatureConfig toggles optional behaviour
+type FeatureConfig struct {
+	AllowNegativeAmounts bool            `yaml:"allow_negative_amounts"`
+	MaxAmount            decimal.Decimal `yaml:"max_amount"`
+}
+
+func defaultConfig() *Config {
+	policy := defaultAmountPolicy()
+	paths := defaultPathOptions()
+	stream := defaultStreamOptions()
+	return &Config{
+		ListenAddress:   ":50051",
+		RequestTimeout:  10 * time.Second,
+		ShutdownTimeout: 15 * time.Second,
+		BaseCurrency:    defaultBaseCurrency,
+		Database: DatabaseConfig{
+			Host:            "localhost",
+			Port:            5432,
+			User:            "postgres",
+			Name:            "currencydb",
+			SSLMode:         "disable",
+			MaxOpenConns:    20,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnectTimeout:  5 * time.Second,
+		},
+		Routing: RoutingConfig{
+			PathStrategy: string(paths.Strategy),
+			MaxHops:      paths.MaxHops,
+		},
+		Features: FeatureConfig{
+			AllowNegativeAmounts: policy.AllowNegative,
+			MaxAmount:            policy.MaxAmount,
+		},
+		Quotes:      QuoteConfig{TTL: defaultQuoteTTL},
+		Idempotency: IdempotencyConfig{Window: defaultIdempotencyWindow},
+		Streaming: StreamConfig{
+			PollInterval:      stream.PollInterval,
+			HeartbeatInterval: stream.HeartbeatInterval,
+			BufferSize:        stream.BufferSize,
+		},
+	}
+}
+
+// setting is a single option that can be supplied as a flag and/or an environment variable
+type setting struct {
+	flag  string
+	env   string
+	usage string
+	set   func(c *Config, v string) error
+}
+
+var settings = []setting{
+	{"listen-address", "CURRENCY_LISTEN_ADDRESS", "address the gRPC server listens on", func(c *Config, v string) error {
+		c.ListenAddress = v
+		return nil
+	}},
+	{"request-timeout", "CURRENCY_REQUEST_TIMEOUT", "maximum duration of a single RPC", func(c *Config, v string) error {
+		return setDuration(&c.RequestTimeout, v)
+	}},
+	{"shutdown-timeout", "CURRENCY_SHUTDOWN_TIMEOUT", "time allowed for in-flight RPCs on shutdown", func(c *Config, v string) error {
+		return setDuration(&c.ShutdownTimeout, v)
+	}},
+	{"base-currency", "CURRENCY_BASE_CURRENCY", "currency the stored conversion rates are relative to", func(c *Config, v string) error {
+		c.BaseCurrency = v
+		return nil
+	}},
+	{"db-dsn", "CURRENCY_DB_DSN", "PostgreSQL connection string, overrides the other db options", func(c *Config, v string) error {
+		c.Database.DSN = v
+		return nil
+	}},
+	{"db-host", "CURRENCY_DB_HOST", "PostgreSQL host", func(c *Config, v string) error {
+		c.Database.Host = v
+		return nil
+	}},
+	{"db-port", "CURRENCY_DB_PORT", "PostgreSQL port", func(c *Config, v string) error {
+		return setInt(&c.Database.Port, v)
+	}},
+	{"db-user", "CURRENCY_DB_USER", "PostgreSQL user", func(c *Config, v string) error {
+		c.Database.User = v
+		return nil
+	}},
+	// The password is deliberately not a flag so it never shows up in process listings
+	{"", "CURRENCY_DB_PASSWORD", "", func(c *Config, v string) error {
+		c.Database.Password = v
+		return nil
+	}},
+	{"db-password-file", "CURRENCY_DB_PASSWORD_FILE", "file containing the PostgreSQL password", func(c *Config, v string) error {
+		c.Database.PasswordFile = v
+		return nil
+	}},
+	{"db-name", "CURRENCY_DB_NAME", "PostgreSQL database name", func(c *Config, v string) error {
+		c.Database.Name = v
+		return nil
+	}},
+	{"db-sslmode", "CURRENCY_DB_SSLMODE", "PostgreSQL sslmode", func(c *Config, v string) error {
+		c.Database.SSLMode = v
+		return nil
+	}},
+	{"db-max-open-conns", "CURRENCY_DB_MAX_OPEN_CONNS", "maximum open database connections", func(c *Config, v string) error {
+		return setInt(&c.Database.MaxOpenConns, v)
+	}},
+	{"db-max-idle-conns", "CURRENCY_DB_MAX_IDLE_CONNS", "maximum idle database connections", func(c *Config, v string) error {
+		return setInt(&c.Database.MaxIdleConns, v)
+	}},
+	{"db-conn-max-lifetime", "CURRENCY_DB_CONN_MAX_LIFETIME", "maximum lifetime of a database connection", func(c *Config, v string) error {
+		return setDuration(&c.Database.ConnMaxLifetime, v)
+	}},
+	{"db-connect-timeout", "CURRENCY_DB_CONNECT_TIMEOUT", "timeout for the initial database ping", func(c *Config, v string) error {
+		return setDuration(&c.Database.ConnectTimeout, v)
+	}},
+	{"path-strategy", "CURRENCY_PATH_STRATEGY", "cross conversion path strategy: fewest_hops or best_rate", func(c *Config, v string) error {
+		c.Routing.PathStrategy = v
+		return nil
+	}},
+	{"max-hops", "CURRENCY_MAX_HOPS", "maximum number of rates in a cross conversion", func(c *Config, v string) error {
+		return setInt(&c.Routing.MaxHops, v)
+	}},
+	{"allow-negative-amounts", "CURRENCY_ALLOW_NEGATIVE_AMOUNTS", "accept negative amounts, e.g. refunds", func(c *Config, v string) error {
+		return setBool(&c.Features.AllowNegativeAmounts, v)
+	}},
+	{"max-amount", "CURRENCY_MAX_AMOUNT", "maximum magnitude of an amount, 0 disables the limit", func(c *Config, v string) error {
+		return setDecimal(&c.Features.MaxAmount, v)
+	}},
+	{"stream-poll-interval", "CURRENCY_STREAM_POLL_INTERVAL", "how often rates are re-read for SubscribeRates streams", func(c *Config, v string) error {
+		return setDuration(&c.Streaming.PollInterval, v)
+	}},
+	{"stream-heartbeat-interval", "CURRENCY_STREAM_HEARTBEAT_INTERVAL", "interval between SubscribeRates heartbeats", func(c *Config, v string) error {
+		return setDuration(&c.Streaming.HeartbeatInterval, v)
+	}},
+	{"stream-buffer-size", "CURRENCY_STREAM_BUFFER_SIZE", "pending updates kept for a slow SubscribeRates client", func(c *Config, v string) error {
+		return setInt(&c.Streaming.BufferSize, v)
+	}},
+	{"quote-ttl", "CURRENCY_QUOTE_TTL", "how long a quoted rate stays locked", func(c *Config, v string) error {
+		return setDuration(&c.Quotes.TTL, v)
+	}},
+	{"idempotency-window", "CURRENCY_IDEMPOTENCY_WINDOW", "how long Convert retries with an idempotency key get the first response", func(c *Config, v string) error {
+		return setDuration(&c.Idempotency.Window, v)
+	}},
+	{"cache-ttl", "CURRENCY_CACHE_TTL", "how long current rates are cached in memory, 0 disables the cache", func(c *Config, v string) error {
+		return setDuration(&c.Cache.TTL, v)
+	}},
+	{"cache-stale-while-revalidate", "CURRENCY_CACHE_STALE_WHILE_REVALIDATE", "how long expired cached rates are served while they are refreshed", func(c *Config, v string) error {
+		return setDuration(&c.Cache.StaleWhileRevalidate, v)
+	}},
+	{"admin-enabled", "CURRENCY_ADMIN_ENABLED", "serve the RateAdmin and ConversionLedger services", func(c *Config, v string) error {
+		return setBool(&c.Admin.Enabled, v)
+	}},
+	{"admin-listen-address", "CURRENCY_ADMIN_LISTEN_ADDRESS", "separate address for the RateAdmin and ConversionLedger services", func(c *Config, v string) error {
+		c.Admin.ListenAddress = v
+		return nil
+	}},
+	{"admin-trust-client-id", "CURRENCY_ADMIN_TRUST_CLIENT_ID", "attribute admin changes to x-client-id; only when callers are authenticated", func(c *Config, v string) error {
+		return setBool(&c.Admin.TrustClientID, v)
+	}},
+}
+
+// loadConfig resolves the configuration from defaults, the config file, the environment and args
+func loadConfig(args []string, getenv func(string) string) (*Config, error) {
+	fs := flag.NewFlagSet("currency-converter", flag.ContinueOnError)
+	configFile := fs.String("config", getenv("CURRENCY_CONFIG"), "path to a YAML config file")
+
+	// Flags are collected first and applied last so they override everything else
+	var flagged []func(c *Config) error
+	for _, st := range settings {
+		if st.flag == "" {
+			continue
+		}
+		st := st
+		fs.Func(st.flag, st.usage, func(v string) error {
+			flagged = append(flagged, func(c *Config) error { return st.set(c, v) })
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := defaultConfig()
+	if *configFile != "" {
+		data, err := os.ReadFile(*configFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("parsing config file %s: %w", *configFile, err)
+		}
+	}
+	for _, st := range settings {
+		if v := getenv(st.env); v != "" {
+			if err := st.set(cfg, v); err != nil {
+				return nil, fmt.Errorf("%s: %w", st.env, err)
+			}
+		}
+	}
+	for _, apply := range flagged {
+		if err := apply(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if c.ListenAddress == "" {
+		return errors.New("listen_address must be set")
+	}
+	if _, err := lookupCurrency(c.BaseCurrency); err != nil {
+		return fmt.Errorf("base_currency: %w", err)
+	}
+	if c.RequestTimeout < 0 || c.ShutdownTimeout < 0 || c.Database.ConnectTimeout < 0 || c.Database.ConnMaxLifetime < 0 {
+		return errors.New("timeouts must not be negative")
+	}
+	if c.Database.MaxOpenConns < 0 || c.Database.MaxIdleConns < 0 {
+		return errors.New("database pool sizes must not be negative")
+	}
+	if err := c.Routing.pathOptions().validate(); err != nil {
+		return fmt.Errorf("routing: %w", err)
+	}
+	if c.Features.MaxAmount.IsNegative() {
+		return errors.New("max_amount must not be negative")
+	}
+	if c.Quotes.TTL <= 0 {
+		return errors.New("quotes.ttl must be positive")
+	}
+	if c.Idempotency.Window <= 0 {
+		return errors.New("idempotency.window must be positive")
+	}
+	if c.Admin.ListenAddress != "" && c.Admin.ListenAddress == c.ListenAddress {
+		return errors.New("admin.listen_address must differ from listen_address; leave it empty to share the listener")
+	}
+	if c.Admin.Enabled && !c.Admin.TrustClientID {
+		return errors.New("admin.enabled requires admin.trust_client_id, as x-client-id is not authenticated")
+	}
+	if err := c.Streaming.streamOptions().validate(); err != nil {
+		return fmt.Errorf("streaming: %w", err)
+	}
+	if err := c.Cache.cacheOptions().validate(); err != nil {
+		return fmt.Errorf("cache: %w", err)
+	}
+	for i, r := range c.Fees.Rules {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("fees.rules[%d]: %w", i, err)
+		}
+		if r.Client != "" && !c.Fees.TrustClientID {
+			return fmt.Errorf("fees.rules[%d]: client rules require fees.trust_client_id, as x-client-id is not authenticated", i)
+		}
+	}
+	return nil
+}
+
+// amountPolicy returns the Convert amount policy described by the feature toggles
+func (c *Config) amountPolicy() amountPolicy {
+	return amountPolicy{
+		AllowNegative: c.Features.AllowNegativeAmounts,
+		MaxAmount:     c.Features.MaxAmount,
+	}
+}
+
+// pathOptions returns the cross conversion path finding options
+func (r RoutingConfig) pathOptions() pathOptions {
+	return pathOptions{Strategy: pathStrategy(r.PathStrategy), MaxHops: r.MaxHops}
+}
+
+// streamOptions returns the SubscribeRates stream options
+func (s StreamConfig) streamOptions() streamOptions {
+	return streamOptions{PollInterval: s.PollInterval, HeartbeatInterval: s.HeartbeatInterval, BufferSize: s.BufferSize}
+}
+
+// cacheOptions returns the rate cache options described by the config
+func (c CacheConfig) cacheOptions() cacheOptions {
+	return cacheOptions{TTL: c.TTL, StaleWhileRevalidate: c.StaleWhileRevalidate}
+}
+
+// connString returns the PostgreSQL connection string, reading the password file if configured
+func (d DatabaseConfig) connString() (string, error) {
+	if d.DSN != "" {
+		return d.DSN, nil
+	}
+	password := d.Password
+	if d.PasswordFile != "" {
+		data, err := os.ReadFile(d.PasswordFile)
+		if err != nil {
+			return "", fmt.Errorf("reading database password file: %w", err)
+		}
+		password = strings.TrimRight(string(data), "\r\n")
+	}
+
+	parts := []string{
+		"host=" + quoteConnValue(d.Host),
+		"port=" + strconv.Itoa(d.Port),
+		"user=" + quoteConnValue(d.User),
+		"dbname=" + quoteConnValue(d.Name),
+		"sslmode=" + quoteConnValue(d.SSLMode),
+	}
+	if password != "" {
+		parts = append(parts, "password="+quoteConnValue(password))
+	}
+	if d.ConnectTimeout > 0 {
+		parts = append(parts, "connect_timeout="+strconv.Itoa(int(math.Ceil(d.ConnectTimeout.Seconds()))))
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// quoteConnValue quotes a keyword/value connection string value
+func quoteConnValue(v string) string {
+	v = strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v)
+	return "'" + v + "'"
+}
+
+func setDuration(dst *time.Duration, v string) error {
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return err
+	}
+	*dst = d
+	return nil
+}
+
+func setInt(dst *int, v string) error {
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return err
+	}
+	*dst = n
+	return nil
+}
+
+func setBool(dst *bool, v string) error {
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return err
+	}
+	*dst = b
+	return nil
+}
+
+func setDecimal(dst *decimal.Decimal, v string) error {
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return err
+	}
+	*dst = d
+	return nil
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func envFrom(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	cfg, err := loadConfig(nil, envFrom(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, ":50051", cfg.ListenAddress)
+	assert.Equal(t, "currencydb", cfg.Database.Name)
+	assert.Equal(t, defaultAmountPolicy(), cfg.amountPolicy())
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+listen_address: ":6000"
+request_timeout: 3s
+database:
+  host: db.internal
+  port: 6432
+  max_open_conns: 50
+features:
+  allow_negative_amounts: false
+  max_amount: 1e9
+`)
+	env := envFrom(map[string]string{
+		"CURRENCY_CONFIG":         path,
+		"CURRENCY_LISTEN_ADDRESS": ":7000",
+		"CURRENCY_DB_HOST":        "db.env",
+	})
+
+	cfg, err := loadConfig([]string{"-listen-address", ":8000"}, env)
+	assert.NoError(t, err)
+	// flag beats env beats file beats default
+	assert.Equal(t, ":8000", cfg.ListenAddress)
+	assert.Equal(t, "db.env", cfg.Database.Host)
+	assert.Equal(t, 6432, cfg.Database.Port)
+	assert.Equal(t, 50, cfg.Database.MaxOpenConns)
+	assert.Equal(t, 3*time.Second, cfg.RequestTimeout)
+	assert.Equal(t, "postgres", cfg.Database.User)
+	assert.False(t, cfg.Features.AllowNegativeAmounts)
+	assert.Equal(t, "1000000000", cfg.Features.MaxAmount.String())
+}
+
+func TestLoadConfigRejectsBadValues(t *testing.T) {
+	_, err := loadConfig([]string{"-db-port", "abc"}, envFrom(nil))
+	assert.Error(t, err)
+
+	_, err = loadConfig(nil, envFrom(map[string]string{"CURRENCY_REQUEST_TIMEOUT": "soon"}))
+	assert.Error(t, err)
+
+	_, err = loadConfig([]string{"-max-amount", "-5"}, envFrom(nil))
+	assert.Error(t, err)
+
+	_, err = loadConfig([]string{"-config", "/does/not/exist.yaml"}, envFrom(nil))
+	assert.Error(t, err)
+}
+
+func TestConnStringReadsPasswordFile(t *testing.T) {
+	path := writeFile(t, "password", "s3cr'et\n")
+	cfg := defaultConfig().Database
+	cfg.Password = "ignored"
+	cfg.PasswordFile = path
+
+	connStr, err := cfg.connString()
+	assert.NoError(t, err)
+	assert.Equal(t, `host='localhost' port=5432 user='postgres' dbname='currencydb' sslmode='disable' password='s3cr\'et' connect_timeout=5`, connStr)
+}
+
+func TestConnStringPrefersDSN(t *testing.T) {
+	cfg := defaultConfig().Database
+	cfg.DSN = "postgres://wallet@db/currencydb"
+
+	connStr, err := cfg.connString()
+	assert.NoError(t, err)
+	assert.Equal(t, "postgres://wallet@db/currencydb", connStr)
+}
+
+func TestLoadConfigBaseCurrency(t *testing.T) {
+	cfg, err := loadConfig([]string{"-base-currency", "EUR"}, envFrom(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", cfg.BaseCurrency)
+
+	_, err = loadConfig(nil, envFrom(map[string]string{"CURRENCY_BASE_CURRENCY": "EURO"}))
+	assert.Error(t, err)
+}
+
+func TestLoadConfigStreaming(t *testing.T) {
+	cfg, err := loadConfig([]string{"-stream-heartbeat-interval", "5s"}, envFrom(map[string]string{"CURRENCY_STREAM_BUFFER_SIZE": "4"}))
+	assert.NoError(t, err)
+	assert.Equal(t, streamOptions{PollInterval: time.Second, HeartbeatInterval: 5 * time.Second, BufferSize: 4}, cfg.Streaming.streamOptions())
+
+	_, err = loadConfig([]string{"-stream-buffer-size", "0"}, envFrom(nil))
+	assert.Error(t, err)
+}
+
+func TestLoadConfigAdmin(t *testing.T) {
+	cfg, err := loadConfig(nil, envFrom(nil))
+	assert.NoError(t, err)
+	assert.False(t, cfg.Admin.Enabled)
+
+	cfg, err = loadConfig([]string{"-admin-enabled", "true", "-admin-listen-address", "127.0.0.1:50052", "-admin-trust-client-id", "true"}, envFrom(nil))
+	assert.NoError(t, err)
+	assert.True(t, cfg.Admin.Enabled)
+	assert.True(t, cfg.Admin.TrustClientID)
+	assert.Equal(t, "127.0.0.1:50052", cfg.Admin.ListenAddress)
+
+	// The audit trail records x-client-id, so it must come from authenticated callers
+	_, err = loadConfig([]string{"-admin-enabled", "true"}, envFrom(nil))
+	assert.ErrorContains(t, err, "admin.trust_client_id")
+
+	_, err = loadConfig([]string{"-admin-listen-address", ":50051"}, envFrom(nil))
+	assert.Error(t, err)
+}
+
+func TestLoadConfigFees(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+fees:
+  trust_client_id: true
+  rules:
+    - name: retail
+      markup_percent: 0.5
+      minimum: 1
+    - name: acme-inr
+      client: acme
+      target: INR
+      fixed: 25
+      maximum: 100
+`)
+	cfg, err := loadConfig(nil, envFrom(map[string]string{"CURRENCY_CONFIG": path}))
+	assert.NoError(t, err)
+	assert.True(t, cfg.Fees.TrustClientID)
+
+	// Client rules are only accepted when x-client-id is known to be authenticated
+	path = writeFile(t, "untrusted.yaml", `
+fees:
+  rules:
+    - client: acme
+      fixed: 25
+`)
+	_, err = loadConfig(nil, envFrom(map[string]string{"CURRENCY_CONFIG": path}))
+	assert.ErrorContains(t, err, "trust_client_id")
+	if assert.Len(t, cfg.Fees.Rules, 2) {
+		assert.Equal(t, "0.5", cfg.Fees.Rules[0].MarkupPercent.String())
+		assert.Equal(t, "acme", cfg.Fees.Rules[1].Client)
+		assert.Equal(t, "25", cfg.Fees.Rules[1].Fixed.String())
+	}
+
+	path = writeFile(t, "bad.yaml", `
+fees:
+  rules:
+    - target: INRR
+`)
+	_, err = loadConfig(nil, envFrom(map[string]string{"CURRENCY_CONFIG": path}))
+	assert.Error(t, err)
+
+	path = writeFile(t, "bad.yaml", `
+fees:
+  rules:
+    - markup_percent: 100
+`)
+	_, err = loadConfig(nil, envFrom(map[string]string{"CURRENCY_CONFIG": path}))
+	assert.Error(t, err)
+}
+
+func TestLoadConfigIdempotency(t *testing.T) {
+	cfg, err := loadConfig(nil, envFrom(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, cfg.Idempotency.Window)
+
+	cfg, err = loadConfig(nil, envFrom(map[string]string{"CURRENCY_IDEMPOTENCY_WINDOW": "1h"}))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, cfg.Idempotency.Window)
+
+	_, err = loadConfig([]string{"-idempotency-window", "0s"}, envFrom(nil))
+	assert.Error(t, err)
+}
+
+func TestLoadConfigCache(t *testing.T) {
+	cfg, err := loadConfig(nil, envFrom(nil))
+	assert.NoError(t, err)
+	assert.Zero(t, cfg.Cache.TTL)
+
+	cfg, err = loadConfig([]string{"-cache-stale-while-revalidate", "30s"}, envFrom(map[string]string{"CURRENCY_CACHE_TTL": "5s"}))
+	assert.NoError(t, err)
+	assert.Equal(t, cacheOptions{TTL: 5 * time.Second, StaleWhileRevalidate: 30 * time.Second}, cfg.Cache.cacheOptions())
+
+	_, err = loadConfig([]string{"-cache-ttl", "-1s"}, envFrom(nil))
+	assert.Error(t, err)
+	_, err = loadConfig([]string{"-cache-stale-while-revalidate", "30s"}, envFrom(nil))
+	assert.Error(t, err)
+}
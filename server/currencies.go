@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// ListCurrencies implements the gRPC method listing the supported currencies and whether they can be converted
+func (s *server) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	params, err := validateListCurrenciesRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.store.AllRates(ctx, time.Time{})
+	if err != nil {
+		log.Printf("Error retrieving all rates: %v", err)
+		return nil, rateLookupError("currencies", err)
+	}
+	// A currency is live when any usable rate involves it
+	updated := make(map[string]time.Time)
+	for _, r := range rates {
+		if !r.Value.IsPositive() {
+			continue
+		}
+		for _, code := range []string{r.Base, r.Quote} {
+			if last, ok := updated[code]; !ok || r.EffectiveFrom.After(last) {
+				updated[code] = r.EffectiveFrom
+			}
+		}
+	}
+
+	res := &pb.ListCurrenciesResponse{}
+	for _, code := range currencyCodes() {
+		if code <= params.after {
+			continue
+		}
+		c := iso4217[code]
+		last, active := updated[code]
+		// The base currency needs no rate of its own
+		active = active || code == s.store.Base()
+		if params.region != pb.Region_REGION_UNSPECIFIED && c.Region != params.region {
+			continue
+		}
+		if params.active != nil && *params.active != active {
+			continue
+		}
+		if len(res.Currencies) == params.pageSize {
+			res.NextPageToken = encodePageToken(res.Currencies[len(res.Currencies)-1].Code)
+			break
+		}
+
+		info := &pb.CurrencyInfo{
+			Code:        c.Code,
+			NumericCode: int32(c.Numeric),
+			Name:        c.Name,
+			Symbol:      c.Symbol,
+			MinorUnits:  c.MinorUnits,
+			Region:      c.Region,
+			Active:      active,
+		}
+		if !last.IsZero() {
+			info.UpdatedAt = timestamppb.New(last)
+		}
+		res.Currencies = append(res.Currencies, info)
+	}
+	return res, nil
+}
+
+// currencyCodes returns the registry's alphabetic codes in order
+func currencyCodes() []string {
+	codes := make([]string, 0, len(iso4217))
+	for code := range iso4217 {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
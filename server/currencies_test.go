@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "CurrencyConverter/proto"
+)
+
+func codesOf(currencies []*pb.CurrencyInfo) []string {
+	codes := make([]string, len(currencies))
+	for i, c := range currencies {
+		codes[i] = c.Code
+	}
+	return codes
+}
+
+func TestListCurrenciesActive(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.ListCurrencies(ctx, &pb.ListCurrenciesRequest{Active: proto.Bool(true)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"EUR", "INR", "JPY", "KWD", "USD"}, codesOf(res.Currencies))
+	assert.Empty(t, res.NextPageToken)
+
+	usd := res.Currencies[4]
+	assert.Equal(t, "US Dollar", usd.Name)
+	assert.Equal(t, "$", usd.Symbol)
+	assert.Equal(t, int32(2), usd.MinorUnits)
+	assert.Equal(t, int32(840), usd.NumericCode)
+	assert.Equal(t, pb.Region_REGION_AMERICAS, usd.Region)
+	assert.True(t, usd.Active)
+	assert.Equal(t, time.Unix(0, 0).UTC(), usd.UpdatedAt.AsTime())
+}
+
+func TestListCurrenciesInactiveByRegion(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.ListCurrencies(ctx, &pb.ListCurrenciesRequest{Region: pb.Region_REGION_OCEANIA, Active: proto.Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"AUD", "FJD", "NZD", "PGK", "SBD", "TOP", "VUV", "WST", "XPF"}, codesOf(res.Currencies))
+	assert.False(t, res.Currencies[0].Active)
+	assert.Nil(t, res.Currencies[0].UpdatedAt)
+}
+
+func TestListCurrenciesPagination(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var all []string
+	req := &pb.ListCurrenciesRequest{PageSize: 60}
+	for {
+		res, err := s.ListCurrencies(ctx, req)
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(res.Currencies), 60)
+		all = append(all, codesOf(res.Currencies)...)
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	assert.Len(t, all, len(iso4217))
+	assert.Equal(t, currencyCodes(), all)
+}
+
+func TestListCurrenciesInvalidRequest(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for _, req := range []*pb.ListCurrenciesRequest{
+		{PageSize: -1},
+		{PageToken: "not a token!"},
+		{Region: pb.Region(42)},
+	} {
+		_, err := s.ListCurrencies(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+
+	pb "CurrencyConverter/proto"
+)
+
+// Currency describes an ISO 4217 currency
+type Currency struct {
+	Code       string
+	Numeric    int
+	MinorUnits int32
+	Name       string
+	// Symbol is the symbol commonly used where the currency is issued
+	Symbol string
+	// Region is the continent of the issuing country, or of most of them for shared currencies
+	Region pb.Region
+}
+
+// iso4217 holds the active ISO 4217 currencies keyed by alphabetic code
+var iso4217 = map[string]Currency{
+	"AED": {"AED", 784, 2, "UAE Dirham", "د.إ", pb.Region_REGION_ASIA},
+	"AFN": {"AFN", 971, 2, "Afghani", "؋", pb.Region_REGION_ASIA},
+	"ALL": {"ALL", 8, 2, "Lek", "L", pb.Region_REGION_EUROPE},
+	"AMD": {"AMD", 51, 2, "Armenian Dram", "֏", pb.Region_REGION_ASIA},
+	"ANG": {"ANG", 532, 2, "Netherlands Antillean Guilder", "ƒ", pb.Region_REGION_AMERICAS},
+	"AOA": {"AOA", 973, 2, "Kwanza", "Kz", pb.Region_REGION_AFRICA},
+	"ARS": {"ARS", 32, 2, "Argentine Peso", "$", pb.Region_REGION_AMERICAS},
+	"AUD": {"AUD", 36, 2, "Australian Dollar", "$", pb.Region_REGION_OCEANIA},
+	"AWG": {"AWG", 533, 2, "Aruban Florin", "ƒ", pb.Region_REGION_AMERICAS},
+	"AZN": {"AZN", 944, 2, "Azerbaijan Manat", "₼", pb.Region_REGION_ASIA},
+	"BAM": {"BAM", 977, 2, "Convertible Mark", "KM", pb.Region_REGION_EUROPE},
+	"BBD": {"BBD", 52, 2, "Barbados Dollar", "$", pb.Region_REGION_AMERICAS},
+	"BDT": {"BDT", 50, 2, "Taka", "৳", pb.Region_REGION_ASIA},
+	"BGN": {"BGN", 975, 2, "Bulgarian Lev", "лв", pb.Region_REGION_EUROPE},
+	"BHD": {"BHD", 48, 3, "Bahraini Dinar", ".د.ب", pb.Region_REGION_ASIA},
+	"BIF": {"BIF", 108, 0, "Burundi Franc", "FBu", pb.Region_REGION_AFRICA},
+	"BMD": {"BMD", 60, 2, "Bermudian Dollar", "$", pb.Region_REGION_AMERICAS},
+	"BND": {"BND", 96, 2, "Brunei Dollar", "$", pb.Region_REGION_ASIA},
+	"BOB": {"BOB", 68, 2, "Boliviano", "Bs", pb.Region_REGION_AMERICAS},
+	"BRL": {"BRL", 986, 2, "Brazilian Real", "R$", pb.Region_REGION_AMERICAS},
+	"BSD": {"BSD", 44, 2, "Bahamian Dollar", "$", pb.Region_REGION_AMERICAS},
+	"BTN": {"BTN", 64, 2, "Ngultrum", "Nu.", pb.Region_REGION_ASIA},
+	"BWP": {"BWP", 72, 2, "Pula", "P", pb.Region_REGION_AFRICA},
+	"BYN": {"BYN", 933, 2, "Belarusian Ruble", "Br", pb.Region_REGION_EUROPE},
+	"BZD": {"BZD", 84, 2, "Belize Dollar", "$", pb.Region_REGION_AMERICAS},
+	"CAD": {"CAD", 124, 2, "Canadian Dollar", "$", pb.Region_REGION_AMERICAS},
+	"CDF": {"CDF", 976, 2, "Congolese Franc", "FC", pb.Region_REGION_AFRICA},
+	"CHF": {"CHF", 756, 2, "Swiss Franc", "CHF", pb.Region_REGION_EUROPE},
+	"CLF": {"CLF", 990, 4, "Unidad de Fomento", "UF", pb.Region_REGION_AMERICAS},
+	"CLP": {"CLP", 152, 0, "Chilean Peso", "$", pb.Region_REGION_AMERICAS},
+	"CNY": {"CNY", 156, 2, "Yuan Renminbi", "¥", pb.Region_REGION_ASIA},
+	"COP": {"COP", 170, 2, "Colombian Peso", "$", pb.Region_REGION_AMERICAS},
+	"CRC": {"CRC", 188, 2, "Costa Rican Colon", "₡", pb.Region_REGION_AMERICAS},
+	"CUP": {"CUP", 192, 2, "Cuban Peso", "$", pb.Region_REGION_AMERICAS},
+	"CVE": {"CVE", 132, 2, "Cabo Verde Escudo", "$", pb.Region_REGION_AFRICA},
+	"CZK": {"CZK", 203, 2, "Czech Koruna", "Kč", pb.Region_REGION_EUROPE},
+	"DJF": {"DJF", 262, 0, "Djibouti Franc", "Fdj", pb.Region_REGION_AFRICA},
+	"DKK": {"DKK", 208, 2, "Danish Krone", "kr", pb.Region_REGION_EUROPE},
+	"DOP": {"DOP", 214, 2, "Dominican Peso", "$", pb.Region_REGION_AMERICAS},
+	"DZD": {"DZD", 12, 2, "Algerian Dinar", "د.ج", pb.Region_REGION_AFRICA},
+	"EGP": {"EGP", 818, 2, "Egyptian Pound", "£", pb.Region_REGION_AFRICA},
+	"ERN": {"ERN", 232, 2, "Nakfa", "Nfk", pb.Region_REGION_AFRICA},
+	"ETB": {"ETB", 230, 2, "Ethiopian Birr", "Br", pb.Region_REGION_AFRICA},
+	"EUR": {"EUR", 978, 2, "Euro", "€", pb.Region_REGION_EUROPE},
+	"FJD": {"FJD", 242, 2, "Fiji Dollar", "$", pb.Region_REGION_OCEANIA},
+	"FKP": {"FKP", 238, 2, "Falkland Islands Pound", "£", pb.Region_REGION_AMERICAS},
+	"GBP": {"GBP", 826, 2, "Pound Sterling", "£", pb.Region_REGION_EUROPE},
+	"GEL": {"GEL", 981, 2, "Lari", "₾", pb.Region_REGION_ASIA},
+	"GHS": {"GHS", 936, 2, "Ghana Cedi", "₵", pb.Region_REGION_AFRICA},
+	"GIP": {"GIP", 292, 2, "Gibraltar Pound", "£", pb.Region_REGION_EUROPE},
+	"GMD": {"GMD", 270, 2, "Dalasi", "D", pb.Region_REGION_AFRICA},
+	"GNF": {"GNF", 324, 0, "Guinean Franc", "FG", pb.Region_REGION_AFRICA},
+	"GTQ": {"GTQ", 320, 2, "Quetzal", "Q", pb.Region_REGION_AMERICAS},
+	"GYD": {"GYD", 328, 2, "Guyana Dollar", "$", pb.Region_REGION_AMERICAS},
+	"HKD": {"HKD", 344, 2, "Hong Kong Dollar", "$", pb.Region_REGION_ASIA},
+	"HNL": {"HNL", 340, 2, "Lempira", "L", pb.Region_REGION_AMERICAS},
+	"HTG": {"HTG", 332, 2, "Gourde", "G", pb.Region_REGION_AMERICAS},
+	"HUF": {"HUF", 348, 2, "Forint", "Ft", pb.Region_REGION_EUROPE},
+	"IDR": {"IDR", 360, 2, "Rupiah", "Rp", pb.Region_REGION_ASIA},
+	"ILS": {"ILS", 376, 2, "New Israeli Sheqel", "₪", pb.Region_REGION_ASIA},
+	"INR": {"INR", 356, 2, "Indian Rupee", "₹", pb.Region_REGION_ASIA},
+	"IQD": {"IQD", 368, 3, "Iraqi Dinar", "ع.د", pb.Region_REGION_ASIA},
+	"IRR": {"IRR", 364, 2, "Iranian Rial", "﷼", pb.Region_REGION_ASIA},
+	"ISK": {"ISK", 352, 0, "Iceland Krona", "kr", pb.Region_REGION_EUROPE},
+	"JMD": {"JMD", 388, 2, "Jamaican Dollar", "$", pb.Region_REGION_AMERICAS},
+	"JOD": {"JOD", 400, 3, "Jordanian Dinar", "د.ا", pb.Region_REGION_ASIA},
+	"JPY": {"JPY", 392, 0, "Yen", "¥", pb.Region_REGION_ASIA},
+	"KES": {"KES", 404, 2, "Kenyan Shilling", "KSh", pb.Region_REGION_AFRICA},
+	"KGS": {"KGS", 417, 2, "Som", "с", pb.Region_REGION_ASIA},
+	"KHR": {"KHR", 116, 2, "Riel", "៛", pb.Region_REGION_ASIA},
+	"KMF": {"KMF", 174, 0, "Comorian Franc", "CF", pb.Region_REGION_AFRICA},
+	"KPW": {"KPW", 408, 2, "North Korean Won", "₩", pb.Region_REGION_ASIA},
+	"KRW": {"KRW", 410, 0, "Won", "₩", pb.Region_REGION_ASIA},
+	"KWD": {"KWD", 414, 3, "Kuwaiti Dinar", "د.ك", pb.Region_REGION_ASIA},
+	"KYD": {"KYD", 136, 2, "Cayman Islands Dollar", "$", pb.Region_REGION_AMERICAS},
+	"KZT": {"KZT", 398, 2, "Tenge", "₸", pb.Region_REGION_ASIA},
+	"LAK": {"LAK", 418, 2, "Lao Kip", "₭", pb.Region_REGION_ASIA},
+	"LBP": {"LBP", 422, 2, "Lebanese Pound", "ل.ل", pb.Region_REGION_ASIA},
+	"LKR": {"LKR", 144, 2, "Sri Lanka Rupee", "Rs", pb.Region_REGION_ASIA},
+	"LRD": {"LRD", 430, 2, "Liberian Dollar", "$", pb.Region_REGION_AFRICA},
+	"LSL": {"LSL", 426, 2, "Loti", "L", pb.Region_REGION_AFRICA},
+	"LYD": {"LYD", 434, 3, "Libyan Dinar", "ل.د", pb.Region_REGION_AFRICA},
+	"MAD": {"MAD", 504, 2, "Moroccan Dirham", "د.م.", pb.Region_REGION_AFRICA},
+	"MDL": {"MDL", 498, 2, "Moldovan Leu", "L", pb.Region_REGION_EUROPE},
+	"MGA": {"MGA", 969, 2, "Malagasy Ariary", "Ar", pb.Region_REGION_AFRICA},
+	"MKD": {"MKD", 807, 2, "Denar", "ден", pb.Region_REGION_EUROPE},
+	"MMK": {"MMK", 104, 2, "Kyat", "K", pb.Region_REGION_ASIA},
+	"MNT": {"MNT", 496, 2, "Tugrik", "₮", pb.Region_REGION_ASIA},
+	"MOP": {"MOP", 446, 2, "Pataca", "MOP$", pb.Region_REGION_ASIA},
+	"MRU": {"MRU", 929, 2, "Ouguiya", "UM", pb.Region_REGION_AFRICA},
+	"MUR": {"MUR", 480, 2, "Mauritius Rupee", "₨", pb.Region_REGION_AFRICA},
+	"MVR": {"MVR", 462, 2, "Rufiyaa", "Rf", pb.Region_REGION_ASIA},
+	"MWK": {"MWK", 454, 2, "Malawi Kwacha", "MK", pb.Region_REGION_AFRICA},
+	"MXN": {"MXN", 484, 2, "Mexican Peso", "$", pb.Region_REGION_AMERICAS},
+	"MYR": {"MYR", 458, 2, "Malaysian Ringgit", "RM", pb.Region_REGION_ASIA},
+	"MZN": {"MZN", 943, 2, "Mozambique Metical", "MT", pb.Region_REGION_AFRICA},
+	"NAD": {"NAD", 516, 2, "Namibia Dollar", "$", pb.Region_REGION_AFRICA},
+	"NGN": {"NGN", 566, 2, "Naira", "₦", pb.Region_REGION_AFRICA},
+	"NIO": {"NIO", 558, 2, "Cordoba Oro", "C$", pb.Region_REGION_AMERICAS},
+	"NOK": {"NOK", 578, 2, "Norwegian Krone", "kr", pb.Region_REGION_EUROPE},
+	"NPR": {"NPR", 524, 2, "Nepalese Rupee", "₨", pb.Region_REGION_ASIA},
+	"NZD": {"NZD", 554, 2, "New Zealand Dollar", "$", pb.Region_REGION_OCEANIA},
+	"OMR": {"OMR", 512, 3, "Rial Omani", "ر.ع.", pb.Region_REGION_ASIA},
+	"PAB": {"PAB", 590, 2, "Balboa", "B/.", pb.Region_REGION_AMERICAS},
+	"PEN": {"PEN", 604, 2, "Sol", "S/", pb.Region_REGION_AMERICAS},
+	"PGK": {"PGK", 598, 2, "Kina", "K", pb.Region_REGION_OCEANIA},
+	"PHP": {"PHP", 608, 2, "Philippine Peso", "₱", pb.Region_REGION_ASIA},
+	"PKR": {"PKR", 586, 2, "Pakistan Rupee", "₨", pb.Region_REGION_ASIA},
+	"PLN": {"PLN", 985, 2, "Zloty", "zł", pb.Region_REGION_EUROPE},
+	"PYG": {"PYG", 600, 0, "Guarani", "₲", pb.Region_REGION_AMERICAS},
+	"QAR": {"QAR", 634, 2, "Qatari Rial", "ر.ق", pb.Region_REGION_ASIA},
+	"RON": {"RON", 946, 2, "Romanian Leu", "lei", pb.Region_REGION_EUROPE},
+	"RSD": {"RSD", 941, 2, "Serbian Dinar", "дин.", pb.Region_REGION_EUROPE},
+	"RUB": {"RUB", 643, 2, "Russian Ruble", "₽", pb.Region_REGION_EUROPE},
+	"RWF": {"RWF", 646, 0, "Rwanda Franc", "FRw", pb.Region_REGION_AFRICA},
+	"SAR": {"SAR", 682, 2, "Saudi Riyal", "ر.س", pb.Region_REGION_ASIA},
+	"SBD": {"SBD", 90, 2, "Solomon Islands Dollar", "$", pb.Region_REGION_OCEANIA},
+	"SCR": {"SCR", 690, 2, "Seychelles Rupee", "₨", pb.Region_REGION_AFRICA},
+	"SDG": {"SDG", 938, 2, "Sudanese Pound", "ج.س.", pb.Region_REGION_AFRICA},
+	"SEK": {"SEK", 752, 2, "Swedish Krona", "kr", pb.Region_REGION_EUROPE},
+	"SGD": {"SGD", 702, 2, "Singapore Dollar", "$", pb.Region_REGION_ASIA},
+	"SHP": {"SHP", 654, 2, "Saint Helena Pound", "£", pb.Region_REGION_AFRICA},
+	"SLE": {"SLE", 925, 2, "Leone", "Le", pb.Region_REGION_AFRICA},
+	"SOS": {"SOS", 706, 2, "Somali Shilling", "Sh", pb.Region_REGION_AFRICA},
+	"SRD": {"SRD", 968, 2, "Surinam Dollar", "$", pb.Region_REGION_AMERICAS},
+	"SSP": {"SSP", 728, 2, "South Sudanese Pound", "£", pb.Region_REGION_AFRICA},
+	"STN": {"STN", 930, 2, "Dobra", "Db", pb.Region_REGION_AFRICA},
+	"SVC": {"SVC", 222, 2, "El Salvador Colon", "₡", pb.Region_REGION_AMERICAS},
+	"SYP": {"SYP", 760, 2, "Syrian Pound", "£", pb.Region_REGION_ASIA},
+	"SZL": {"SZL", 748, 2, "Lilangeni", "L", pb.Region_REGION_AFRICA},
+	"THB": {"THB", 764, 2, "Baht", "฿", pb.Region_REGION_ASIA},
+	"TJS": {"TJS", 972, 2, "Somoni", "SM", pb.Region_REGION_ASIA},
+	"TMT": {"TMT", 934, 2, "Turkmenistan New Manat", "m", pb.Region_REGION_ASIA},
+	"TND": {"TND", 788, 3, "Tunisian Dinar", "د.ت", pb.Region_REGION_AFRICA},
+	"TOP": {"TOP", 776, 2, "Pa'anga", "T$", pb.Region_REGION_OCEANIA},
+	"TRY": {"TRY", 949, 2, "Turkish Lira", "₺", pb.Region_REGION_ASIA},
+	"TTD": {"TTD", 780, 2, "Trinidad and Tobago Dollar", "$", pb.Region_REGION_AMERICAS},
+	"TWD": {"TWD", 901, 2, "New Taiwan Dollar", "NT$", pb.Region_REGION_ASIA},
+	"TZS": {"TZS", 834, 2, "Tanzanian Shilling", "TSh", pb.Region_REGION_AFRICA},
+	"UAH": {"UAH", 980, 2, "Hryvnia", "₴", pb.Region_REGION_EUROPE},
+	"UGX": {"UGX", 800, 0, "Uganda Shilling", "USh", pb.Region_REGION_AFRICA},
+	"USD": {"USD", 840, 2, "US Dollar", "$", pb.Region_REGION_AMERICAS},
+	"UYU": {"UYU", 858, 2, "Peso Uruguayo", "$", pb.Region_REGION_AMERICAS},
+	"UYW": {"UYW", 927, 4, "Unidad Previsional", "UP", pb.Region_REGION_AMERICAS},
+	"UZS": {"UZS", 860, 2, "Uzbekistan Sum", "soʻm", pb.Region_REGION_ASIA},
+	"VED": {"VED", 926, 2, "Bolivar Soberano", "Bs.D", pb.Region_REGION_AMERICAS},
+	"VES": {"VES", 928, 2, "Bolivar Soberano", "Bs.S", pb.Region_REGION_AMERICAS},
+	"VND": {"VND", 704, 0, "Dong", "₫", pb.Region_REGION_ASIA},
+	"VUV": {"VUV", 548, 0, "Vatu", "VT", pb.Region_REGION_OCEANIA},
+	"WST": {"WST", 882, 2, "Tala", "T", pb.Region_REGION_OCEANIA},
+	"XAF": {"XAF", 950, 0, "CFA Franc BEAC", "FCFA", pb.Region_REGION_AFRICA},
+	"XCD": {"XCD", 951, 2, "East Caribbean Dollar", "$", pb.Region_REGION_AMERICAS},
+	"XOF": {"XOF", 952, 0, "CFA Franc BCEAO", "CFA", pb.Region_REGION_AFRICA},
+	"XPF": {"XPF", 953, 0, "CFP Franc", "₣", pb.Region_REGION_OCEANIA},
+	"YER": {"YER", 886, 2, "Yemeni Rial", "﷼", pb.Region_REGION_ASIA},
+	"ZAR": {"ZAR", 710, 2, "Rand", "R", pb.Region_REGION_AFRICA},
+	"ZMW": {"ZMW", 967, 2, "Zambian Kwacha", "ZK", pb.Region_REGION_AFRICA},
+	"ZWG": {"ZWG", 924, 2, "Zimbabwe Gold", "ZiG", pb.Region_REGION_AFRICA},
+}
+
+// lookupCurrency returns the registry entry for an alphabetic currency code
+func lookupCurrency(code string) (Currency, error) {
+	c, ok := iso4217[code]
+	if !ok {
+		return Currency{}, fmt.Errorf("unknown currency %s", code)
+	}
+	return c, nil
+}
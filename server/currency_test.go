@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	c, err := lookupCurrency("JPY")
+	assert.NoError(t, err)
+	assert.Equal(t, Currency{Code: "JPY", Numeric: 392, MinorUnits: 0, Name: "Yen", Symbol: "¥", Region: pb.Region_REGION_ASIA}, c)
+
+	c, err = lookupCurrency("KWD")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), c.MinorUnits)
+}
+
+func TestLookupCurrencyUnknown(t *testing.T) {
+	for _, code := range []string{"", "XYZ", "usd", "INVALID"} {
+		_, err := lookupCurrency(code)
+		assert.Error(t, err, code)
+	}
+}
+
+func TestRegistryKeysMatchCodes(t *testing.T) {
+	numerics := make(map[int]string)
+	for code, c := range iso4217 {
+		assert.Equal(t, code, c.Code)
+		assert.Len(t, code, 3)
+		assert.NotEmpty(t, c.Symbol, code)
+		assert.NotEqual(t, pb.Region_REGION_UNSPECIFIED, c.Region, code)
+		if other, ok := numerics[c.Numeric]; ok {
+			t.Errorf("numeric code %d used by both %s and %s", c.Numeric, other, code)
+		}
+		numerics[c.Numeric] = code
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log"
+	"net"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// retryDelay is the back-off suggested to clients after a transient store failure
+const retryDelay = time.Second
+
+// fieldViolation describes a single invalid request field
+func fieldViolation(field string, err error) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: err.Error()}
+}
+
+// invalidArgumentError builds an InvalidArgument status carrying the field violations
+func invalidArgumentError(violations ...*errdetails.BadRequest_FieldViolation) error {
+	msg := "invalid request"
+	if len(violations) == 1 {
+		msg = violations[0].GetDescription()
+	}
+	return withDetails(status.New(codes.InvalidArgument, msg), &errdetails.BadRequest{FieldViolations: violations})
+}
+
+// rateLookupError maps a rate store failure for a currency onto a gRPC status
+func rateLookupError(currency string, err error) error {
+	if errors.Is(err, ErrRateNotFound) {
+		return withDetails(status.Newf(codes.NotFound, "conversion rate not found for %s", currency), &errdetails.ResourceInfo{
+			ResourceType: "conversion_rate",
+			ResourceName: currency,
+			Description:  "no conversion rate is configured for this currency",
+		})
+	}
+	return storeError("retrieving conversion rate for "+currency, err)
+}
+
+// storeError maps a store failure while doing what, e.g. "accessing quote", onto a gRPC status.
+// Callers handle their store's sentinel errors before falling back to it.
+func storeError(what string, err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "timed out "+what)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled "+what)
+	case isTransient(err):
+		return withDetails(status.New(codes.Unavailable, "store unavailable "+what), &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryDelay),
+		})
+	default:
+		return status.Error(codes.Internal, "failed "+what)
+	}
+}
+
+// rateWriteError maps a failure to record rate changes onto a gRPC status
+func rateWriteError(err error) error {
+	if errors.Is(err, ErrRateConflict) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return storeError("recording rate changes", err)
+}
+
+// quoteError maps a quote store failure onto a gRPC status
+func quoteError(id string, err error) error {
+	switch {
+	case errors.Is(err, ErrQuoteNotFound):
+		return withDetails(status.Newf(codes.NotFound, "quote %s not found", id), &errdetails.ResourceInfo{
+			ResourceType: "quote",
+			ResourceName: id,
+		})
+	case errors.Is(err, ErrQuoteExpired):
+		return withDetails(status.Newf(codes.FailedPrecondition, "quote %s has expired", id), &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: "QUOTE_EXPIRED", Subject: id, Description: "request a new quote"}},
+		})
+	case errors.Is(err, ErrQuoteExecuted):
+		return withDetails(status.Newf(codes.FailedPrecondition, "quote %s has already been executed", id), &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: "QUOTE_EXECUTED", Subject: id, Description: "a quote can only be executed once"}},
+		})
+	default:
+		return storeError("accessing quote", err)
+	}
+}
+
+// idempotencyError maps an idempotency store failure or a reused key onto a gRPC status
+func idempotencyError(key string, err error) error {
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		return withDetails(status.Newf(codes.FailedPrecondition, "idempotency key %s was already used for a different conversion", key), &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: "IDEMPOTENCY_KEY_REUSED", Subject: key, Description: "use a new idempotency key for a new conversion"}},
+		})
+	case errors.Is(err, ErrIdempotencyKeyInProgress), errors.Is(err, ErrIdempotencyReservationLost):
+		// Retrying with the same key returns the conversion once it completes
+		return withDetails(status.Newf(codes.Aborted, "idempotency key %s is in use by another conversion", key), &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryDelay),
+		})
+	default:
+		return storeError("accessing idempotency key", err)
+	}
+}
+
+// ledgerError maps a conversion ledger failure onto a gRPC status
+func ledgerError(err error) error {
+	return storeError("accessing the conversion ledger", err)
+}
+
+// isTransient reports whether a store error is worth retrying
+func isTransient(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Class() {
+		// connection exception, transaction rollback, insufficient resources, operator intervention
+		case "08", "40", "53", "57":
+			return true
+		}
+	}
+	return false
+}
+
+// withDetails attaches details to a status, falling back to the bare status if they cannot be encoded
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	withDetail, err := st.WithDetails(details...)
+	if err != nil {
+		log.Printf("Error attaching error details: %v", err)
+		return st.Err()
+	}
+	return withDetail.Err()
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLookupErrorNotFound(t *testing.T) {
+	err := rateLookupError("GBP", fmt.Errorf("%w for GBP", ErrRateNotFound))
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		info := st.Details()[0].(*errdetails.ResourceInfo)
+		assert.Equal(t, "conversion_rate", info.ResourceType)
+		assert.Equal(t, "GBP", info.ResourceName)
+	}
+}
+
+func TestRateLookupErrorTransient(t *testing.T) {
+	err := rateLookupError("USD", &pq.Error{Code: "08006"})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		retry := st.Details()[0].(*errdetails.RetryInfo)
+		assert.Equal(t, time.Second, retry.RetryDelay.AsDuration())
+	}
+}
+
+func TestRateLookupErrorDeadline(t *testing.T) {
+	err := rateLookupError("USD", fmt.Errorf("query: %w", context.DeadlineExceeded))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestRateLookupErrorUnknownFailure(t *testing.T) {
+	assert.Equal(t, codes.Internal, status.Code(rateLookupError("USD", errors.New("boom"))))
+	assert.Equal(t, codes.Internal, status.Code(rateLookupError("USD", &pq.Error{Code: "42601"})))
+}
+
+func TestInvalidArgumentErrorCollectsViolations(t *testing.T) {
+	err := invalidArgumentError(
+		fieldViolation("source_currency", errors.New("unknown currency XYZ")),
+		fieldViolation("target_currency", errors.New("unknown currency ABC")),
+	)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "invalid request", st.Message())
+	if assert.Len(t, st.Details(), 1) {
+		assert.Len(t, st.Details()[0].(*errdetails.BadRequest).FieldViolations, 2)
+	}
+}
+
+func TestStoreErrorsKeepSentinels(t *testing.T) {
+	assert.Equal(t, codes.AlreadyExists, status.Code(rateWriteError(fmt.Errorf("%w: USD/INR", ErrRateConflict))))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(quoteError("q1", ErrQuoteExpired)))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(idempotencyError("k1", ErrIdempotencyKeyReused)))
+
+	// Other failures are mapped the same way whichever store they come from
+	for _, err := range []error{
+		rateWriteError(context.Canceled),
+		quoteError("q1", context.Canceled),
+		idempotencyError("k1", context.Canceled),
+		ledgerError(context.Canceled),
+	} {
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	}
+	st := status.Convert(ledgerError(&pq.Error{Code: "57P01"}))
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Equal(t, "store unavailable accessing the conversion ledger", st.Message())
+	assert.Len(t, st.Details(), 1)
+}
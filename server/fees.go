@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	pb "CurrencyConverter/proto"
+)
+
+// FeeRule charges a fee on conversions it matches. Empty Client, Source and Target match anything.
+// The fee is charged in the target currency: MarkupPercent of the converted amount plus Fixed,
+// raised to Minimum and capped at Maximum when they are set.
+type FeeRule struct {
+	Name          string          `yaml:"name"`
+	Client        string          `yaml:"client"`
+	Source        string          `yaml:"source"`
+	Target        string          `yaml:"target"`
+	MarkupPercent decimal.Decimal `yaml:"markup_percent"`
+	Fixed         decimal.Decimal `yaml:"fixed"`
+	Minimum       decimal.Decimal `yaml:"minimum"`
+	Maximum       decimal.Decimal `yaml:"maximum"`
+}
+
+func (r FeeRule) validate() error {
+	for _, code := range []string{r.Source, r.Target} {
+		if code == "" {
+			continue
+		}
+		if _, err := lookupCurrency(code); err != nil {
+			return err
+		}
+	}
+	if r.MarkupPercent.IsNegative() || r.MarkupPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return errors.New("markup_percent must be at least 0 and below 100")
+	}
+	if r.Fixed.IsNegative() || r.Minimum.IsNegative() || r.Maximum.IsNegative() {
+		return errors.New("fixed, minimum and maximum must not be negative")
+	}
+	if !r.Maximum.IsZero() && r.Maximum.LessThan(r.Minimum) {
+		return errors.New("maximum must not be below minimum")
+	}
+	return nil
+}
+
+// specificity ranks a matching rule: a client match outweighs a source match, which outweighs a target match
+func (r FeeRule) specificity() int {
+	n := 0
+	if r.Client != "" {
+		n += 4
+	}
+	if r.Source != "" {
+		n += 2
+	}
+	if r.Target != "" {
+		n++
+	}
+	return n
+}
+
+func (r FeeRule) matches(client, source, target string) bool {
+	return (r.Client == "" || r.Client == client) &&
+		(r.Source == "" || r.Source == source) &&
+		(r.Target == "" || r.Target == target)
+}
+
+// fee computes the fee on a converted amount, rounded half up to the target currency's minor units
+func (r FeeRule) fee(converted decimal.Decimal, minorUnits int32) decimal.Decimal {
+	fee := converted.Mul(r.MarkupPercent).Div(decimal.NewFromInt(100)).Add(r.Fixed)
+	if fee.LessThan(r.Minimum) {
+		fee = r.Minimum
+	}
+	if !r.Maximum.IsZero() && fee.GreaterThan(r.Maximum) {
+		fee = r.Maximum
+	}
+	return fee.Round(minorUnits)
+}
+
+// feeSchedule holds the configured fee rules
+type feeSchedule []FeeRule
+
+// match returns the most specific rule matching a conversion, preferring the first of equally specific rules
+func (f feeSchedule) match(client, source, target string) (FeeRule, bool) {
+	best, found := FeeRule{}, false
+	for _, r := range f {
+		if r.matches(client, source, target) && (!found || r.specificity() > best.specificity()) {
+			best, found = r, true
+		}
+	}
+	return best, found
+}
+
+// feeResult splits a conversion into what it is worth, what is charged and what the client receives,
+// or on SIDE_SELL what the client pays
+type feeResult struct {
+	gross decimal.Decimal
+	fee   decimal.Decimal
+	net   decimal.Decimal
+	// charged reports whether a rule matched; rule is its name
+	charged bool
+	rule    string
+}
+
+// apply charges the matching fee on an unrounded converted amount. The fee is deducted from what
+// the client receives, except on SIDE_SELL, where the converted amount is what the client pays and
+// the fee is added to it. Refunds and other non-positive amounts are not charged.
+func (f feeSchedule) apply(params convertParams, converted decimal.Decimal) feeResult {
+	gross := roundAmount(converted, params.target.MinorUnits, params.roundingMode)
+	res := feeResult{gross: gross, fee: decimal.Zero, net: gross}
+	if rule, ok := f.match(params.client, params.source.Code, params.target.Code); ok && converted.IsPositive() {
+		res.fee = rule.fee(converted, params.target.MinorUnits)
+		if params.side == pb.Side_SIDE_SELL {
+			res.net = gross.Add(res.fee)
+		} else {
+			res.net = gross.Sub(res.fee)
+		}
+		res.charged, res.rule = true, rule.Name
+	}
+	return res
+}
+
+// stepDescription describes how the fee changes the amount in an explained calculation
+func (r feeResult) stepDescription(side pb.Side) string {
+	if side == pb.Side_SIDE_SELL {
+		return fmt.Sprintf("add fee (rule %q)", r.rule)
+	}
+	return fmt.Sprintf("subtract fee (rule %q)", r.rule)
+}
+
+// breakdown describes the fee result for a response
+func (r feeResult) breakdown(currency string) (*pb.FeeBreakdown, error) {
+	gross, err := moneyFromDecimal(r.gross, currency)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := moneyFromDecimal(r.fee, currency)
+	if err != nil {
+		return nil, err
+	}
+	net, err := moneyFromDecimal(r.net, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FeeBreakdown{Gross: gross, Fee: fee, Net: net, Rule: r.rule}, nil
+}
+
+// checkCovered rejects conversions whose fee exceeds what they are worth. A fee added on
+// SIDE_SELL never leaves the net amount below the gross one, so only deducted fees can fail.
+func (r feeResult) checkCovered(params convertParams) error {
+	if r.charged && r.net.IsNegative() {
+		return invalidArgumentError(fieldViolation(params.amountField,
+			fmt.Errorf("amount does not cover the fee of %s %s", r.fee, params.target.Code)))
+	}
+	return nil
+}
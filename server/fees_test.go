@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	pb "CurrencyConverter/proto"
+)
+
+func testFees() feeSchedule {
+	return feeSchedule{
+		{Name: "retail", MarkupPercent: decimal.RequireFromString("0.5"), Minimum: decimal.NewFromInt(1)},
+		{Name: "usd-inr", Source: "USD", Target: "INR", MarkupPercent: decimal.RequireFromString("0.25")},
+		{Name: "acme", Client: "acme", Fixed: decimal.NewFromInt(25), Maximum: decimal.NewFromInt(100)},
+	}
+}
+
+func clientContext(t *testing.T, client string) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(clientIDHeader, client))
+}
+
+func TestFeeScheduleMatch(t *testing.T) {
+	fees := testFees()
+	for _, tc := range []struct {
+		client, source, target, want string
+	}{
+		{"", "EUR", "INR", "retail"},
+		{"", "USD", "INR", "usd-inr"},
+		{"acme", "USD", "INR", "acme"},
+		{"other", "USD", "JPY", "retail"},
+	} {
+		rule, ok := fees.match(tc.client, tc.source, tc.target)
+		assert.True(t, ok)
+		assert.Equal(t, tc.want, rule.Name, "%s %s->%s", tc.client, tc.source, tc.target)
+	}
+
+	_, ok := feeSchedule{}.match("", "USD", "INR")
+	assert.False(t, ok)
+}
+
+func TestFeeRuleMinimumAndMaximum(t *testing.T) {
+	rule := FeeRule{
+		MarkupPercent: decimal.NewFromInt(1),
+		Fixed:         decimal.RequireFromString("0.5"),
+		Minimum:       decimal.NewFromInt(2),
+		Maximum:       decimal.NewFromInt(10),
+	}
+	assert.Equal(t, "2", rule.fee(decimal.NewFromInt(100), 2).String())
+	assert.Equal(t, "5.5", rule.fee(decimal.NewFromInt(500), 2).String())
+	assert.Equal(t, "10", rule.fee(decimal.NewFromInt(5000), 2).String())
+	// Rounded half up to the target currency's minor units
+	assert.Equal(t, "2.51", rule.fee(decimal.RequireFromString("200.5"), 2).String())
+}
+
+func TestConvertChargesFees(t *testing.T) {
+	s := newTestServer()
+	s.fees = testFees()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.FeeBreakdown{
+		Gross: &pb.Money{CurrencyCode: "INR", Units: 8312},
+		Fee:   &pb.Money{CurrencyCode: "INR", Units: 20, Nanos: 780000000},
+		Net:   &pb.Money{CurrencyCode: "INR", Units: 8291, Nanos: 220000000},
+		Rule:  "usd-inr",
+	}, res.Fees)
+	assert.Equal(t, res.Fees.Net, res.ConvertedMoney)
+	assert.Equal(t, 8291.22, res.ConvertedAmount)
+	assert.Equal(t, "8312", res.UnroundedAmount)
+
+	res, err = s.Convert(clientContext(t, "acme"), &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", res.Fees.Rule)
+	assert.Equal(t, &pb.Money{CurrencyCode: "INR", Units: 8287}, res.ConvertedMoney)
+}
+
+func TestConvertWithoutFees(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Money{CurrencyCode: "INR"}, res.Fees.Fee)
+	assert.Equal(t, res.Fees.Gross, res.Fees.Net)
+	assert.Equal(t, res.ConvertedMoney, res.Fees.Net)
+	assert.Empty(t, res.Fees.Rule)
+}
+
+func TestConvertRejectsAmountBelowFee(t *testing.T) {
+	s := newTestServer()
+	s.fees = testFees()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// 0.01 EUR is 0.90 INR, below the 1 INR minimum fee
+	_, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 0.01, SourceCurrency: "EUR", TargetCurrency: "INR"})
+	assert.Equal(t, []string{"amount"}, violatedFields(t, err))
+
+	// Refunds are not charged, so there is no fee to cover
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: -100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, -8312.0, res.ConvertedAmount)
+}
+
+func TestConvertToTargetCoversFees(t *testing.T) {
+	s := newTestServer()
+	s.fees = testFees()
+	ctx := clientContext(t, "acme")
+
+	res, err := s.ConvertToTarget(ctx, &pb.ConvertToTargetRequest{TargetAmount: 10000, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", res.Conversion.Fees.Rule)
+	assert.Equal(t, &pb.Money{CurrencyCode: "INR", Units: 10000, Nanos: 100000000}, res.Conversion.ConvertedMoney)
+	assert.Equal(t, 120.61, res.SourceAmount)
+
+	// One cent less no longer covers the fee
+	less, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 120.60, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.True(t, less.ConvertedAmount < 10000)
+}
+
+func TestConvertSellAddsFee(t *testing.T) {
+	s := newSpreadTestServer()
+	s.fees = testFees()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	buy, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_BUY})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Money{CurrencyCode: "INR", Units: 8279, Nanos: 250000000}, buy.ConvertedMoney)
+
+	// Selling INR for 100 USD costs the gross amount plus the fee
+	sell, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_SELL, Explain: true})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.FeeBreakdown{
+		Gross: &pb.Money{CurrencyCode: "INR", Units: 8324},
+		Fee:   &pb.Money{CurrencyCode: "INR", Units: 20, Nanos: 810000000},
+		Net:   &pb.Money{CurrencyCode: "INR", Units: 8344, Nanos: 810000000},
+		Rule:  "usd-inr",
+	}, sell.Fees)
+	assert.Equal(t, sell.Fees.Net, sell.ConvertedMoney)
+	steps := sell.Explain
+	if assert.NotEmpty(t, steps) {
+		assert.Equal(t, `add fee (rule "usd-inr")`, steps[len(steps)-1].Description)
+	}
+
+	// A fixed fee larger than the amount is still covered when it is paid on top
+	_, err = s.Convert(clientContext(t, "acme"), &pb.ConvertRequest{Amount: 0.01, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_SELL})
+	assert.NoError(t, err)
+
+	res, err := s.ConvertToTarget(ctx, &pb.ConvertToTargetRequest{TargetAmount: 10000, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_SELL})
+	assert.NoError(t, err)
+	assert.True(t, res.Conversion.ConvertedAmount >= 10000)
+	less, err := s.Convert(ctx, &pb.ConvertRequest{Amount: res.SourceAmount - 0.01, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_SELL})
+	assert.NoError(t, err)
+	assert.True(t, less.ConvertedAmount < 10000)
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// pathStrategy chooses between the candidate paths of a cross conversion
+type pathStrategy string
+
+const (
+	// pathFewestHops picks the shortest path, breaking ties by the best effective rate
+	pathFewestHops pathStrategy = "fewest_hops"
+	// pathBestRate picks the path that yields the most target currency, breaking ties by length
+	pathBestRate pathStrategy = "best_rate"
+)
+
+// pathOptions controls cross-rate path finding
+type pathOptions struct {
+	Strategy pathStrategy
+	MaxHops  int
+}
+
+func defaultPathOptions() pathOptions {
+	return pathOptions{Strategy: pathFewestHops, MaxHops: 4}
+}
+
+func (o pathOptions) validate() error {
+	switch o.Strategy {
+	case pathFewestHops, pathBestRate:
+	default:
+		return fmt.Errorf("unknown path strategy %s", o.Strategy)
+	}
+	if o.MaxHops < 1 {
+		return fmt.Errorf("max hops must be at least 1, got %d", o.MaxHops)
+	}
+	return nil
+}
+
+// from returns the currency a hop converts from
+func (h hop) from() string {
+	if h.inverse {
+		return h.rate.Quote
+	}
+	return h.rate.Base
+}
+
+// to returns the currency a hop converts to
+func (h hop) to() string {
+	if h.inverse {
+		return h.rate.Base
+	}
+	return h.rate.Quote
+}
+
+// rateGraph connects currencies by every known pivot and pair rate, in both directions
+type rateGraph struct {
+	edges map[string][]hop
+}
+
+func newRateGraph(rates []Rate) *rateGraph {
+	g := &rateGraph{edges: make(map[string][]hop)}
+	for _, r := range rates {
+		// Unusable rates would make the path's effective rate meaningless
+		if !r.Value.IsPositive() || r.Base == r.Quote {
+			continue
+		}
+		g.edges[r.Base] = append(g.edges[r.Base], hop{rate: r})
+		g.edges[r.Quote] = append(g.edges[r.Quote], hop{rate: r, inverse: true})
+	}
+	// Keep the search deterministic regardless of the order rates were loaded in
+	for _, hops := range g.edges {
+		sort.Slice(hops, func(i, j int) bool {
+			if hops[i].to() != hops[j].to() {
+				return hops[i].to() < hops[j].to()
+			}
+			return hops[i].rate.Base < hops[j].rate.Base
+		})
+	}
+	return g
+}
+
+// pathLabel is the best path found so far to a currency and the rate it yields
+type pathLabel struct {
+	hops []hop
+	rate decimal.Decimal
+}
+
+// extend returns the path continued by h
+func (l pathLabel) extend(h hop) pathLabel {
+	hops := append(append(make([]hop, 0, len(l.hops)+1), l.hops...), h)
+	return pathLabel{hops: hops, rate: route{hops: hops}.apply(decimal.NewFromInt(1))}
+}
+
+// visits reports whether the path already passes through currency
+func (l pathLabel) visits(currency string) bool {
+	for _, h := range l.hops {
+		if h.from() == currency || h.to() == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// findPath finds a path of at most opts.MaxHops hops from source to target. The work is bounded
+// by MaxHops times the number of rates, whatever the shape of the graph.
+func (g *rateGraph) findPath(source, target string, opts pathOptions) ([]hop, bool) {
+	if source == target {
+		return nil, false
+	}
+	if opts.Strategy == pathBestRate {
+		return g.bestRatePath(source, target, opts.MaxHops)
+	}
+	return g.fewestHopsPath(source, target, opts.MaxHops)
+}
+
+// fewestHopsPath searches breadth first, keeping for every currency the best rate among its shortest paths
+func (g *rateGraph) fewestHopsPath(source, target string, maxHops int) ([]hop, bool) {
+	reached := map[string]pathLabel{source: {rate: decimal.NewFromInt(1)}}
+	frontier := []string{source}
+	for depth := 1; depth <= maxHops && len(frontier) > 0; depth++ {
+		next := make(map[string]pathLabel)
+		var order []string
+		for _, currency := range frontier {
+			from := reached[currency]
+			for _, h := range g.edges[currency] {
+				to := h.to()
+				if _, ok := reached[to]; ok {
+					continue
+				}
+				candidate := from.extend(h)
+				current, ok := next[to]
+				if ok && !candidate.rate.GreaterThan(current.rate) {
+					continue
+				}
+				if !ok {
+					order = append(order, to)
+				}
+				next[to] = candidate
+			}
+		}
+		if l, ok := next[target]; ok {
+			return l.hops, true
+		}
+		for _, currency := range order {
+			reached[currency] = next[currency]
+		}
+		frontier = order
+	}
+	return nil, false
+}
+
+// bestRatePath relaxes the best path to every currency once per hop, expanding only the currencies
+// improved in the previous round. Ties keep the shorter path. Only one path is kept per currency,
+// so a better path that has to avoid a currency already on that path can be missed.
+func (g *rateGraph) bestRatePath(source, target string, maxHops int) ([]hop, bool) {
+	best := map[string]pathLabel{source: {rate: decimal.NewFromInt(1)}}
+	updated := []string{source}
+	for depth := 1; depth <= maxHops && len(updated) > 0; depth++ {
+		improved := make(map[string]pathLabel)
+		var order []string
+		for _, currency := range updated {
+			if currency == target {
+				continue
+			}
+			from := best[currency]
+			for _, h := range g.edges[currency] {
+				to := h.to()
+				if to == source || from.visits(to) {
+					continue
+				}
+				candidate := from.extend(h)
+				current, ok := improved[to]
+				if !ok {
+					current, ok = best[to]
+				}
+				if ok && !candidate.rate.GreaterThan(current.rate) {
+					continue
+				}
+				if _, ok := improved[to]; !ok {
+					order = append(order, to)
+				}
+				improved[to] = candidate
+			}
+		}
+		for _, currency := range order {
+			best[currency] = improved[currency]
+		}
+		updated = order
+	}
+	l, ok := best[target]
+	return l.hops, ok
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func rate(base, quote, value string) Rate {
+	return Rate{Base: base, Quote: quote, Value: decimal.RequireFromString(value)}
+}
+
+func pathOf(source string, hops []hop) []string {
+	return route{source: source, hops: hops}.path()
+}
+
+func TestFindPathFewestHops(t *testing.T) {
+	g := newRateGraph([]Rate{
+		rate("USD", "INR", "83"),
+		rate("EUR", "INR", "90"),
+		rate("CHF", "EUR", "1.05"),
+		rate("CHF", "GBP", "0.9"),
+		rate("GBP", "USD", "1.3"),
+	})
+
+	hops, ok := g.findPath("CHF", "INR", pathOptions{Strategy: pathFewestHops, MaxHops: 4})
+	assert.True(t, ok)
+	// CHF->EUR->INR beats CHF->GBP->USD->INR
+	assert.Equal(t, []string{"CHF", "EUR", "INR"}, pathOf("CHF", hops))
+
+	hops, ok = g.findPath("INR", "CHF", pathOptions{Strategy: pathFewestHops, MaxHops: 4})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"INR", "EUR", "CHF"}, pathOf("INR", hops))
+	assert.True(t, hops[0].inverse)
+	assert.True(t, hops[1].inverse)
+}
+
+func TestFindPathBestRate(t *testing.T) {
+	g := newRateGraph([]Rate{
+		rate("USD", "INR", "83"),
+		rate("EUR", "INR", "90"),
+		rate("CHF", "EUR", "1.05"),
+		rate("CHF", "GBP", "0.9"),
+		rate("GBP", "USD", "1.3"),
+	})
+
+	// CHF->EUR->INR yields 94.5, CHF->GBP->USD->INR yields 97.11
+	hops, ok := g.findPath("CHF", "INR", pathOptions{Strategy: pathBestRate, MaxHops: 4})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"CHF", "GBP", "USD", "INR"}, pathOf("CHF", hops))
+	assert.Equal(t, "97.11", route{hops: hops}.apply(decimal.NewFromInt(1)).String())
+
+	// Too long once hops are capped
+	hops, ok = g.findPath("CHF", "INR", pathOptions{Strategy: pathBestRate, MaxHops: 2})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"CHF", "EUR", "INR"}, pathOf("CHF", hops))
+}
+
+func TestFindPathNoPath(t *testing.T) {
+	g := newRateGraph([]Rate{
+		rate("USD", "INR", "83"),
+		rate("CHF", "EUR", "1.05"),
+		rate("JPY", "INR", "0"),
+	})
+
+	_, ok := g.findPath("CHF", "INR", defaultPathOptions())
+	assert.False(t, ok)
+
+	// Zero rates are not usable edges
+	_, ok = g.findPath("JPY", "USD", defaultPathOptions())
+	assert.False(t, ok)
+}
+
+func TestPathOptionsValidate(t *testing.T) {
+	assert.NoError(t, defaultPathOptions().validate())
+	assert.Error(t, pathOptions{Strategy: "cheapest", MaxHops: 3}.validate())
+	assert.Error(t, pathOptions{Strategy: pathBestRate}.validate())
+}
+
+func TestFindPathDenseGraphIsBounded(t *testing.T) {
+	// Every currency is quoted against every other, so there are billions of simple paths of 8 hops
+	var currencies []string
+	for c := 'A'; c < 'A'+30; c++ {
+		currencies = append(currencies, "X"+string(c)+"X")
+	}
+	var rates []Rate
+	for i, base := range currencies {
+		for _, quote := range currencies[i+1:] {
+			rates = append(rates, rate(base, quote, "1.01"))
+		}
+	}
+	g := newRateGraph(rates)
+
+	for _, strategy := range []pathStrategy{pathFewestHops, pathBestRate} {
+		start := time.Now()
+		_, ok := g.findPath("XAX", "INR", pathOptions{Strategy: strategy, MaxHops: 8})
+		assert.False(t, ok)
+		hops, ok := g.findPath("XAX", "XBX", pathOptions{Strategy: strategy, MaxHops: 8})
+		assert.True(t, ok)
+		assert.NotEmpty(t, hops)
+		assert.Less(t, time.Since(start), 5*time.Second, strategy)
+	}
+}
+
+func TestSnapshotStoreSharesRateGraph(t *testing.T) {
+	snapshot := newSnapshotStore("INR", []Rate{rate("USD", "INR", "83"), rate("CHF", "EUR", "1.05")})
+	first, err := snapshot.RateGraph(context.Background(), time.Time{})
+	assert.NoError(t, err)
+	second, err := storeRateGraph(context.Background(), snapshot, time.Time{})
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// streamOptions controls SubscribeRates streams
+type streamOptions struct {
+	// PollInterval is how often rates are re-read while anyone is subscribed. It catches changes
+	// the store does not notify about, such as a rate whose effective_from has just passed.
+	PollInterval      time.Duration
+	HeartbeatInterval time.Duration
+	// BufferSize is the number of pending snapshots kept per subscriber
+	BufferSize int
+}
+
+func defaultStreamOptions() streamOptions {
+	return streamOptions{
+		PollInterval:      time.Second,
+		HeartbeatInterval: 15 * time.Second,
+		BufferSize:        16,
+	}
+}
+
+func (o streamOptions) validate() error {
+	if o.PollInterval <= 0 || o.HeartbeatInterval <= 0 {
+		return errors.New("poll_interval and heartbeat_interval must be positive")
+	}
+	if o.BufferSize < 1 {
+		return errors.New("buffer_size must be at least 1")
+	}
+	return nil
+}
+
+// changeNotifier signals, without blocking, that rates may have changed
+type changeNotifier interface {
+	Changes() <-chan struct{}
+}
+
+// rateHub re-reads the rates while there are subscribers and fans each snapshot out to them,
+// so the store is queried once per change rather than once per subscriber
+type rateHub struct {
+	store    RateStore
+	notifier changeNotifier
+	opts     streamOptions
+
+	mu   sync.Mutex
+	subs map[*subscriber]struct{}
+	stop context.CancelFunc
+}
+
+// subscriber receives the snapshots taken by the hub
+type subscriber struct {
+	snapshots chan *snapshotStore
+}
+
+// newRateHub creates a hub reading from store; notifier may be nil, leaving polling as the only trigger
+func newRateHub(store RateStore, notifier changeNotifier, opts streamOptions) *rateHub {
+	return &rateHub{
+		store:    store,
+		notifier: notifier,
+		opts:     opts,
+		subs:     make(map[*subscriber]struct{}),
+	}
+}
+
+// notifierOf returns the store's change notifier, if it has one
+func notifierOf(store RateStore) changeNotifier {
+	n, _ := store.(changeNotifier)
+	return n
+}
+
+// subscribe registers a subscriber, starting the refresh loop for the first one
+func (h *rateHub) subscribe() *subscriber {
+	sub := &subscriber{snapshots: make(chan *snapshotStore, h.opts.BufferSize)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subs[sub] = struct{}{}
+	if len(h.subs) == 1 {
+		ctx, cancel := context.WithCancel(context.Background())
+		h.stop = cancel
+		go h.run(ctx)
+	}
+	return sub
+}
+
+// unsubscribe removes a subscriber, stopping the refresh loop after the last one
+func (h *rateHub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs, sub)
+	if len(h.subs) == 0 && h.stop != nil {
+		h.stop()
+		h.stop = nil
+	}
+}
+
+func (h *rateHub) run(ctx context.Context) {
+	ticker := time.NewTicker(h.opts.PollInterval)
+	defer ticker.Stop()
+	var changes <-chan struct{}
+	if h.notifier != nil {
+		changes = h.notifier.Changes()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-changes:
+		}
+		h.refresh(ctx)
+	}
+}
+
+// refresh takes a snapshot of the current rates and offers it to every subscriber
+func (h *rateHub) refresh(ctx context.Context) {
+	snapshot, err := takeSnapshot(ctx, h.store, time.Time{})
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Error retrieving rate snapshot: %v", err)
+		}
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		sub.offer(snapshot)
+	}
+}
+
+// offer queues a snapshot without blocking, dropping the oldest pending one when a slow subscriber's
+// buffer is full. Snapshots are complete, so skipping one still leaves the subscriber with the latest rates.
+func (s *subscriber) offer(snapshot *snapshotStore) {
+	for {
+		select {
+		case s.snapshots <- snapshot:
+			return
+		default:
+		}
+		select {
+		case <-s.snapshots:
+		default:
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/metadata"
+
+	pb "CurrencyConverter/proto"
+)
+
+// idempotencyKeyHeader is the metadata key an idempotency key may be sent in
+const idempotencyKeyHeader = "idempotency-key"
+
+// maxIdempotencyKeyLength caps the length of an idempotency key
+const maxIdempotencyKeyLength = 255
+
+// defaultIdempotencyWindow is how long a result is returned for retries unless configured otherwise
+const defaultIdempotencyWindow = 24 * time.Hour
+
+// idempotencyReservationTimeout is how long a key stays reserved for a conversion that never
+// completes or releases it, e.g. because the server stopped, before a retry may convert again
+const idempotencyReservationTimeout = time.Minute
+
+// requestIdempotencyKey returns the idempotency key sent in the request or its metadata, or "" if
+// there is none. A key sent both ways must be the same.
+func requestIdempotencyKey(ctx context.Context, fromRequest string) (string, error) {
+	key := fromRequest
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(idempotencyKeyHeader); len(keys) > 0 && keys[0] != "" {
+			if key != "" && key != keys[0] {
+				return "", fmt.Errorf("idempotency_key differs from the %s metadata", idempotencyKeyHeader)
+			}
+			key = keys[0]
+		}
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return "", fmt.Errorf("idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+	if strings.IndexFunc(key, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return "", errors.New("idempotency key must only contain printable characters")
+	}
+	return key, nil
+}
+
+// fingerprint identifies the parameters of a conversion, so a key reused for a different
+// conversion can be told apart from a retry. An amount sent as amount or as amount_money is the same.
+func (p convertParams) fingerprint() string {
+	var asOf string
+	if !p.asOf.IsZero() {
+		asOf = p.asOf.UTC().Format(time.RFC3339Nano)
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%s|%s|%t|%s",
+		p.amount, p.source.Code, p.target.Code, p.roundingMode, asOf, p.explain, p.side)))
+	return hex.EncodeToString(sum[:])
+}
+
+// convertOnce performs a conversion at most once per client and idempotency key within the
+// idempotency window, returning the first response to every retry. The key is reserved before
+// converting, so a retry while the first request is still converting is rejected instead of
+// converting again. Failed conversions release the key, so a retry after an error converts again.
+func (s *server) convertOnce(ctx context.Context, key string, req *pb.ConvertRequest, params convertParams) (*pb.ConvertResponse, error) {
+	id, err := newConversionID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	reservation := IdempotentResult{
+		ClientID:     callerIdentity(ctx),
+		Key:          key,
+		Fingerprint:  params.fingerprint(),
+		ConversionID: id,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(idempotencyReservationTimeout),
+	}
+	stored, reserved, err := s.idempotency.ReserveKey(ctx, reservation, now)
+	if err != nil {
+		log.Printf("Error reserving idempotency key %s: %v", key, err)
+		return nil, idempotencyError(key, err)
+	}
+	if !reserved {
+		return replayResult(stored, key, reservation.Fingerprint)
+	}
+
+	res, err := s.convertReserved(ctx, req, params, reservation)
+	if err != nil {
+		// Released even if the request was canceled, so a retry need not wait for the reservation to expire
+		if err := s.idempotency.ReleaseKey(context.WithoutCancel(ctx), reservation); err != nil {
+			log.Printf("Error releasing idempotency key %s: %v", key, err)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// convertReserved converts for a reserved key, then stores the response for the key and records
+// the conversion in the ledger together, so a key never has more than one recorded conversion
+func (s *server) convertReserved(ctx context.Context, req *pb.ConvertRequest, params convertParams, reservation IdempotentResult) (*pb.ConvertResponse, error) {
+	res, rt, err := s.convert(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := convertRecord(ctx, reservation.ConversionID, req, params, res, rt, reservation.Key)
+	if err != nil {
+		return nil, err
+	}
+	result := reservation
+	result.Response = res
+	result.ExpiresAt = time.Now().Add(s.idempotencyWindow)
+	if err := s.idempotency.CompleteResult(ctx, result, rec); err != nil {
+		log.Printf("Error storing idempotency key %s with conversion %s: %v", reservation.Key, rec.ID, err)
+		return nil, idempotencyError(reservation.Key, err)
+	}
+	return res, nil
+}
+
+// replayResult returns a stored response if it was made for the same parameters
+func replayResult(stored IdempotentResult, key, fingerprint string) (*pb.ConvertResponse, error) {
+	if stored.Fingerprint != fingerprint {
+		return nil, idempotencyError(key, ErrIdempotencyKeyReused)
+	}
+	if stored.pending() {
+		return nil, idempotencyError(key, ErrIdempotencyKeyInProgress)
+	}
+	return stored.Response, nil
+}
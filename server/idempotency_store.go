@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pb "CurrencyConverter/proto"
+)
+
+var (
+	// ErrIdempotencyKeyNotFound is returned by an IdempotencyStore when a key has no unexpired result
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+	// ErrIdempotencyKeyReused is returned when a key is reused for a request with different parameters
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with different parameters")
+	// ErrIdempotencyKeyInProgress is returned for a retry while the first request with its key is still converting
+	ErrIdempotencyKeyInProgress = errors.New("idempotency key in use by a conversion in progress")
+	// ErrIdempotencyReservationLost is returned by CompleteResult when the reservation expired and
+	// the key was reserved again
+	ErrIdempotencyReservationLost = errors.New("idempotency key reservation lost")
+)
+
+// IdempotentResult is the response to the first request made with an idempotency key, or while
+// that request is still converting, the reservation of the key for it
+type IdempotentResult struct {
+	// ClientID and Key identify the result; keys of different clients never collide
+	ClientID string
+	Key      string
+	// Fingerprint identifies the parameters of the request the key was first used with
+	Fingerprint string
+	// ConversionID identifies the conversion the key was reserved for
+	ConversionID string
+	// Response is nil while the key is reserved
+	Response  *pb.ConvertResponse
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// pending reports whether the result is a reservation whose conversion has not completed
+func (r IdempotentResult) pending() bool {
+	return r.Response == nil
+}
+
+// IdempotencyStore persists the results of idempotent requests
+type IdempotencyStore interface {
+	// ReserveKey reserves a client's key for r, a pending result, unless an unexpired result or
+	// reservation exists for the key. It returns whichever is stored afterwards and whether it is r,
+	// so concurrent requests agree on which of them converts.
+	ReserveKey(ctx context.Context, r IdempotentResult, now time.Time) (IdempotentResult, bool, error)
+	// CompleteResult stores the response for a key reserved for r.ConversionID and records the
+	// conversion in the ledger in the same transaction. It stores neither if the reservation was lost.
+	CompleteResult(ctx context.Context, r IdempotentResult, conversion ConversionRecord) error
+	// ReleaseKey drops a reservation whose conversion failed, so a retry converts again
+	ReleaseKey(ctx context.Context, r IdempotentResult) error
+}
+
+// idempotencyKey identifies a stored result
+type idempotencyKey struct {
+	clientID, key string
+}
+
+// memoryIdempotencyStore keeps results in memory, for tests and local development. Completed
+// conversions are recorded in ledger while the store is locked.
+type memoryIdempotencyStore struct {
+	ledger Ledger
+
+	mu      sync.Mutex
+	results map[idempotencyKey]IdempotentResult
+}
+
+func newMemoryIdempotencyStore(ledger Ledger) *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{ledger: ledger, results: make(map[idempotencyKey]IdempotentResult)}
+}
+
+func (m *memoryIdempotencyStore) ReserveKey(ctx context.Context, r IdempotentResult, now time.Time) (IdempotentResult, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return IdempotentResult{}, false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k := idempotencyKey{r.ClientID, r.Key}
+	if existing, ok := m.results[k]; ok && now.Before(existing.ExpiresAt) {
+		return existing, false, nil
+	}
+	m.results[k] = r
+	return r, true, nil
+}
+
+func (m *memoryIdempotencyStore) CompleteResult(ctx context.Context, r IdempotentResult, conversion ConversionRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k := idempotencyKey{r.ClientID, r.Key}
+	if existing, ok := m.results[k]; !ok || !existing.pending() || existing.ConversionID != r.ConversionID {
+		return ErrIdempotencyReservationLost
+	}
+	if err := m.ledger.RecordConversion(ctx, conversion); err != nil {
+		return err
+	}
+	m.results[k] = r
+	return nil
+}
+
+func (m *memoryIdempotencyStore) ReleaseKey(ctx context.Context, r IdempotentResult) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k := idempotencyKey{r.ClientID, r.Key}
+	if existing, ok := m.results[k]; ok && existing.pending() && existing.ConversionID == r.ConversionID {
+		delete(m.results, k)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "CurrencyConverter/proto"
+)
+
+// postgresIdempotencyStore keeps results in the convert_idempotency_keys table so retries
+// are recognised across restarts and replicas. Completed conversions are recorded in the
+// conversions table in the same transaction.
+type postgresIdempotencyStore struct {
+	db *sql.DB
+}
+
+func newPostgresIdempotencyStore(db *sql.DB) *postgresIdempotencyStore {
+	return &postgresIdempotencyStore{db: db}
+}
+
+const idempotencyColumns = "client_id, idempotency_key, fingerprint, conversion_id, response, created_at, expires_at"
+
+func (p *postgresIdempotencyStore) ReserveKey(ctx context.Context, r IdempotentResult, now time.Time) (IdempotentResult, bool, error) {
+	// An expired result or reservation is replaced; an unexpired one is kept and returned instead
+	stored, err := p.scanResult(p.db.QueryRowContext(ctx, `INSERT INTO convert_idempotency_keys (`+idempotencyColumns+`)
+		VALUES ($1, $2, $3, $4, NULL, $5, $6)
+		ON CONFLICT (client_id, idempotency_key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint, conversion_id = EXCLUDED.conversion_id, response = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE convert_idempotency_keys.expires_at <= $7
+		RETURNING `+idempotencyColumns,
+		r.ClientID, r.Key, r.Fingerprint, r.ConversionID, r.CreatedAt, r.ExpiresAt, now))
+	if !errors.Is(err, ErrIdempotencyKeyNotFound) {
+		return stored, err == nil, err
+	}
+	stored, err = p.scanResult(p.db.QueryRowContext(ctx, `SELECT `+idempotencyColumns+` FROM convert_idempotency_keys
+		WHERE client_id = $1 AND idempotency_key = $2 AND expires_at > $3`, r.ClientID, r.Key, now))
+	return stored, false, err
+}
+
+func (p *postgresIdempotencyStore) CompleteResult(ctx context.Context, r IdempotentResult, conversion ConversionRecord) error {
+	response, err := proto.Marshal(r.Response)
+	if err != nil {
+		return err
+	}
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE convert_idempotency_keys SET response = $4, expires_at = $5
+		WHERE client_id = $1 AND idempotency_key = $2 AND conversion_id = $3 AND response IS NULL`,
+		r.ClientID, r.Key, r.ConversionID, response, r.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrIdempotencyReservationLost
+	}
+	if err := insertConversion(ctx, tx, conversion); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (p *postgresIdempotencyStore) ReleaseKey(ctx context.Context, r IdempotentResult) error {
+	_, err := p.db.ExecContext(ctx, `DELETE FROM convert_idempotency_keys
+		WHERE client_id = $1 AND idempotency_key = $2 AND conversion_id = $3 AND response IS NULL`,
+		r.ClientID, r.Key, r.ConversionID)
+	return err
+}
+
+func (p *postgresIdempotencyStore) scanResult(row *sql.Row) (IdempotentResult, error) {
+	var r IdempotentResult
+	var response []byte
+	err := row.Scan(&r.ClientID, &r.Key, &r.Fingerprint, &r.ConversionID, &response, &r.CreatedAt, &r.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return IdempotentResult{}, ErrIdempotencyKeyNotFound
+	}
+	if err != nil {
+		return IdempotentResult{}, err
+	}
+	// A reservation has no response yet
+	if response == nil {
+		return r, nil
+	}
+	r.Response = &pb.ConvertResponse{}
+	if err := proto.Unmarshal(response, r.Response); err != nil {
+		return IdempotentResult{}, err
+	}
+	return r, nil
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestConvertIdempotencyKeyReturnsFirstResponse(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-1"}
+	first, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "8312", first.UnroundedAmount)
+
+	// A retry after the rate changed still gets the first result
+	s.store.(*memoryStore).Set("USD", decimal.RequireFromString("84"))
+	retry, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(first, retry))
+
+	// The same amount sent as amount_money is the same request
+	retry, err = s.Convert(ctx, &pb.ConvertRequest{
+		AmountMoney:    &pb.Money{CurrencyCode: "USD", Units: 100},
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+		IdempotencyKey: "transfer-1",
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(first, retry))
+
+	// Without a key every call converts again
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, "8400", res.UnroundedAmount)
+}
+
+func TestConvertIdempotencyKeyFromMetadata(t *testing.T) {
+	s := newTestServer()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "transfer-2"))
+
+	first, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	s.store.(*memoryStore).Set("USD", decimal.RequireFromString("84"))
+	retry, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-2"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(first, retry))
+
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-3"})
+	assert.Equal(t, []string{"idempotency_key"}, violatedFields(t, err))
+
+	_, err = s.Convert(context.Background(), &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: strings.Repeat("k", 256)})
+	assert.Equal(t, []string{"idempotency_key"}, violatedFields(t, err))
+}
+
+func TestConvertIdempotencyKeyReusedForDifferentRequest(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+
+	_, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-4"})
+	assert.NoError(t, err)
+	for _, req := range []*pb.ConvertRequest{
+		{Amount: 101, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-4"},
+		{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "INR", IdempotencyKey: "transfer-4"},
+		{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-4", Side: pb.Side_SIDE_BUY},
+	} {
+		_, err := s.Convert(ctx, req)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), req.String())
+		assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", preconditionType(err))
+	}
+}
+
+func TestConvertIdempotencyKeysAreScopedToClient(t *testing.T) {
+	s := newTestServer()
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-5"}
+
+	_, err := s.Convert(clientContext(t, "acme"), req)
+	assert.NoError(t, err)
+	s.store.(*memoryStore).Set("USD", decimal.RequireFromString("84"))
+	res, err := s.Convert(clientContext(t, "globex"), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "8400", res.UnroundedAmount)
+}
+
+func TestConvertIdempotencyWindowExpires(t *testing.T) {
+	s := newTestServer()
+	s.idempotencyWindow = time.Millisecond
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-6"}
+
+	_, err := s.Convert(context.Background(), req)
+	assert.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	s.store.(*memoryStore).Set("USD", decimal.RequireFromString("84"))
+	res, err := s.Convert(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "8400", res.UnroundedAmount)
+}
+
+func TestConvertIdempotencyKeyDoesNotStoreFailures(t *testing.T) {
+	s := newTestServer()
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "GBP", TargetCurrency: "INR", IdempotencyKey: "transfer-7"}
+
+	_, err := s.Convert(context.Background(), req)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	s.store.(*memoryStore).Set("GBP", decimal.RequireFromString("105"))
+	res, err := s.Convert(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "10500", res.UnroundedAmount)
+}
+
+func TestConvertIdempotencyKeyConcurrentRetries(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-8"}
+
+	responses := make([]*pb.ConvertResponse, 8)
+	var wg sync.WaitGroup
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i == len(responses)/2 {
+				s.store.(*memoryStore).Set("USD", decimal.RequireFromString("84"))
+			}
+			res, err := s.Convert(ctx, req)
+			if err != nil {
+				// A retry while the first request is converting is told to retry later
+				assert.Equal(t, codes.Aborted, status.Code(err))
+				return
+			}
+			responses[i] = res
+		}(i)
+	}
+	wg.Wait()
+
+	first, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	for _, res := range responses {
+		if res != nil {
+			assert.True(t, proto.Equal(first, res))
+		}
+	}
+	records, err := s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+}
+
+func TestConvertIdempotencyKeyInProgress(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-9"}
+	params, err := s.policy.validateConvertRequest(req)
+	assert.NoError(t, err)
+
+	// Another request holds the key while it converts
+	now := time.Now()
+	pending := IdempotentResult{Key: "transfer-9", Fingerprint: params.fingerprint(), ConversionID: "cnv-1", CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+	_, reserved, err := s.idempotency.ReserveKey(ctx, pending, now)
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+
+	_, err = s.Convert(ctx, req)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	records, err := s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	// A different conversion is still refused
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 5, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-9"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Once the other request gives up the key, a retry converts
+	assert.NoError(t, s.idempotency.ReleaseKey(ctx, pending))
+	res, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "8312", res.UnroundedAmount)
+}
+
+func TestBatchConvertIgnoresIdempotencyKey(t *testing.T) {
+	s := newTestServer()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "batch-1"))
+
+	res, err := s.BatchConvert(ctx, &pb.BatchConvertRequest{Items: []*pb.ConvertItem{
+		{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"},
+		{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "INR"},
+	}})
+	assert.NoError(t, err)
+	for _, r := range res.Results {
+		assert.NotNil(t, r.GetResponse())
+	}
+}
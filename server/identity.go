@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// clientIDHeader is the metadata key callers identify themselves with
+const clientIDHeader = "x-client-id"
+
+// Trace IDs are read from W3C traceparent metadata, falling back to x-request-id
+const (
+	traceparentHeader = "traceparent"
+	requestIDHeader   = "x-request-id"
+)
+
+// callerIdentity returns the identity the caller sent in the x-client-id metadata, or "" if it sent none
+func callerIdentity(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md.Get(clientIDHeader); len(ids) > 0 {
+		return strings.TrimSpace(ids[0])
+	}
+	return ""
+}
+
+// traceID returns the trace ID of the call from its traceparent metadata, or its x-request-id
+// when there is no valid traceparent, or "" if it sent neither
+func traceID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if parents := md.Get(traceparentHeader); len(parents) > 0 {
+		// version-traceid-parentid-flags, e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+		if fields := strings.Split(strings.TrimSpace(parents[0]), "-"); len(fields) == 4 && len(fields[1]) == 32 {
+			if _, err := hex.DecodeString(fields[1]); err == nil {
+				return strings.ToLower(fields[1])
+			}
+		}
+	}
+	if ids := md.Get(requestIDHeader); len(ids) > 0 {
+		return strings.TrimSpace(ids[0])
+	}
+	return ""
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// recordConversion assigns a conversion made by Convert its ID, stored in res, and records it in the ledger
+func (s *server) recordConversion(ctx context.Context, req *pb.ConvertRequest, params convertParams, res *pb.ConvertResponse, rt route) error {
+	id, err := newConversionID()
+	if err != nil {
+		return err
+	}
+	rec, err := convertRecord(ctx, id, req, params, res, rt, "")
+	if err != nil {
+		return err
+	}
+	if err := s.ledger.RecordConversion(ctx, rec); err != nil {
+		log.Printf("Error recording conversion %s: %v", id, err)
+		return ledgerError(err)
+	}
+	return nil
+}
+
+// newConversionID returns a new ID to record a conversion under
+func newConversionID() (string, error) {
+	id, err := newRandomID()
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create conversion ID: %v", err)
+	}
+	return id, nil
+}
+
+// convertRecord describes a conversion made by Convert for the ledger
+func convertRecord(ctx context.Context, id string, req *pb.ConvertRequest, params convertParams, res *pb.ConvertResponse, rt route, key string) (ConversionRecord, error) {
+	return completeRecord(ctx, id, ConversionRecord{
+		IdempotencyKey: key,
+		Source:         params.source.Code,
+		Target:         params.target.Code,
+		Amount:         params.amount,
+		Rate:           rt.rate(),
+		Request:        req,
+	}, res)
+}
+
+// completeRecord fills in what every recorded conversion has: its ID, which is also stored in res,
+// the caller and res itself
+func completeRecord(ctx context.Context, id string, rec ConversionRecord, res *pb.ConvertResponse) (ConversionRecord, error) {
+	res.ConversionId = id
+	converted, err := decimalFromMoney(res.GetConvertedMoney())
+	if err != nil {
+		return rec, status.Errorf(codes.Internal, "invalid converted amount: %v", err)
+	}
+	rec.ID = id
+	rec.ClientID = callerIdentity(ctx)
+	rec.TraceID = traceID(ctx)
+	rec.Converted = converted
+	rec.Response = res
+	// Postgres keeps microseconds, so listings page the same way from either store
+	rec.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	return rec, nil
+}
+
+// ledgerServer implements the ConversionLedger service
+type ledgerServer struct {
+	pb.UnimplementedConversionLedgerServer
+	ledger Ledger
+}
+
+func newLedgerServer(ledger Ledger) *ledgerServer {
+	return &ledgerServer{ledger: ledger}
+}
+
+// ListConversions implements the gRPC method listing recorded conversions, newest first
+func (l *ledgerServer) ListConversions(ctx context.Context, req *pb.ListConversionsRequest) (*pb.ListConversionsResponse, error) {
+	if _, err := requireCaller(ctx); err != nil {
+		return nil, err
+	}
+	filter, limit, err := validateListConversionsRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra conversion to find out whether there is another page
+	records, err := l.ledger.ListConversions(ctx, filter, limit+1)
+	if err != nil {
+		log.Printf("Error listing conversions: %v", err)
+		return nil, ledgerError(err)
+	}
+
+	res := &pb.ListConversionsResponse{}
+	if len(records) > limit {
+		records = records[:limit]
+		last := records[len(records)-1]
+		res.NextPageToken = encodePageToken(last.CreatedAt.Format(time.RFC3339Nano) + " " + last.ID)
+	}
+	for _, r := range records {
+		res.Conversions = append(res.Conversions, conversionRecordProto(r))
+	}
+	return res, nil
+}
+
+// parseConversionCursor splits the key of a ListConversions page token
+func parseConversionCursor(key string) (time.Time, string, bool) {
+	at, id, ok := strings.Cut(key, " ")
+	if !ok || id == "" {
+		return time.Time{}, "", false
+	}
+	t, err := time.Parse(time.RFC3339Nano, at)
+	return t, id, err == nil
+}
+
+// conversionRecordProto describes a recorded conversion for a response
+func conversionRecordProto(r ConversionRecord) *pb.ConversionRecord {
+	return &pb.ConversionRecord{
+		ConversionId:   r.ID,
+		ClientId:       r.ClientID,
+		TraceId:        r.TraceID,
+		IdempotencyKey: r.IdempotencyKey,
+		Request:        r.Request,
+		Response:       r.Response,
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+	}
+}
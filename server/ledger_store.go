@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	pb "CurrencyConverter/proto"
+)
+
+// ErrConversionNotFound is returned when the ledger has no conversion with the requested ID
+var ErrConversionNotFound = errors.New("conversion not found")
+
+// ConversionRecord is a conversion performed by Convert, kept so wallet transactions can be
+// reconciled against the rates they used
+type ConversionRecord struct {
+	ID             string
+	ClientID       string
+	TraceID        string
+	IdempotencyKey string
+	Source         string
+	Target         string
+	// Amount is the source amount and Converted the amount returned in converted_money
+	Amount    decimal.Decimal
+	Converted decimal.Decimal
+	// Rate is the effective rate of the conversion
+	Rate      decimal.Decimal
+	Request   *pb.ConvertRequest
+	Response  *pb.ConvertResponse
+	CreatedAt time.Time
+}
+
+// ConversionFilter selects conversions from the ledger; zero fields match everything
+type ConversionFilter struct {
+	ClientID string
+	Source   string
+	Target   string
+	// From is inclusive and Until exclusive
+	From  time.Time
+	Until time.Time
+	// AfterTime and AfterID continue a listing after the conversion they identify
+	AfterTime time.Time
+	AfterID   string
+}
+
+// Ledger persists conversions
+type Ledger interface {
+	RecordConversion(ctx context.Context, r ConversionRecord) error
+	// Conversion returns the conversion with the given ID or ErrConversionNotFound
+	Conversion(ctx context.Context, id string) (ConversionRecord, error)
+	// ListConversions returns up to limit conversions matching the filter, newest first,
+	// ordered by CreatedAt and then ID
+	ListConversions(ctx context.Context, filter ConversionFilter, limit int) ([]ConversionRecord, error)
+}
+
+// memoryLedger keeps conversions in memory, for tests and local development
+type memoryLedger struct {
+	mu      sync.Mutex
+	records []ConversionRecord
+}
+
+func newMemoryLedger() *memoryLedger {
+	return &memoryLedger{}
+}
+
+func (m *memoryLedger) RecordConversion(ctx context.Context, r ConversionRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.records {
+		if existing.ID == r.ID {
+			return fmt.Errorf("conversion %s already recorded", r.ID)
+		}
+	}
+	m.records = append(m.records, r)
+	return nil
+}
+
+func (m *memoryLedger) Conversion(ctx context.Context, id string) (ConversionRecord, error) {
+	if err := ctx.Err(); err != nil {
+		return ConversionRecord{}, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range m.records {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return ConversionRecord{}, fmt.Errorf("%w: %s", ErrConversionNotFound, id)
+}
+
+func (m *memoryLedger) ListConversions(ctx context.Context, filter ConversionFilter, limit int) ([]ConversionRecord, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var matched []ConversionRecord
+	for _, r := range m.records {
+		if filter.matches(r) {
+			matched = append(matched, r)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return newerConversion(matched[i], matched[j].CreatedAt, matched[j].ID) })
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, nil
+}
+
+func (f ConversionFilter) matches(r ConversionRecord) bool {
+	return (f.ClientID == "" || r.ClientID == f.ClientID) &&
+		(f.Source == "" || r.Source == f.Source) &&
+		(f.Target == "" || r.Target == f.Target) &&
+		(f.From.IsZero() || !r.CreatedAt.Before(f.From)) &&
+		(f.Until.IsZero() || r.CreatedAt.Before(f.Until)) &&
+		(f.AfterTime.IsZero() || newerConversion(ConversionRecord{CreatedAt: f.AfterTime, ID: f.AfterID}, r.CreatedAt, r.ID))
+}
+
+// newerConversion reports whether r comes before the conversion created at t with the given ID, newest first
+func newerConversion(r ConversionRecord, t time.Time, id string) bool {
+	if !r.CreatedAt.Equal(t) {
+		return r.CreatedAt.After(t)
+	}
+	return r.ID > id
+}
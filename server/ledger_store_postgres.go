@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "CurrencyConverter/proto"
+)
+
+// postgresLedger records conversions in the conversions table
+type postgresLedger struct {
+	db *sql.DB
+}
+
+func newPostgresLedger(db *sql.DB) *postgresLedger {
+	return &postgresLedger{db: db}
+}
+
+const conversionColumns = `conversion_id, client_id, trace_id, idempotency_key, source_currency, target_currency,
+	amount, converted_amount, rate, request, response, created_at`
+
+func (p *postgresLedger) RecordConversion(ctx context.Context, r ConversionRecord) error {
+	return insertConversion(ctx, p.db, r)
+}
+
+// execer runs a statement on the database or in a transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// insertConversion adds a conversion to the conversions table, so other stores can record one in
+// the same transaction as their own changes
+func insertConversion(ctx context.Context, db execer, r ConversionRecord) error {
+	request, err := proto.Marshal(r.Request)
+	if err != nil {
+		return err
+	}
+	response, err := proto.Marshal(r.Response)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, `INSERT INTO conversions (`+conversionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		r.ID, r.ClientID, r.TraceID, r.IdempotencyKey, r.Source, r.Target,
+		r.Amount, r.Converted, r.Rate, request, response, r.CreatedAt)
+	return err
+}
+
+func (p *postgresLedger) ListConversions(ctx context.Context, f ConversionFilter, limit int) ([]ConversionRecord, error) {
+	from := sql.NullTime{Time: f.From, Valid: !f.From.IsZero()}
+	until := sql.NullTime{Time: f.Until, Valid: !f.Until.IsZero()}
+	afterTime := sql.NullTime{Time: f.AfterTime, Valid: !f.AfterTime.IsZero()}
+	rows, err := p.db.QueryContext(ctx, `SELECT `+conversionColumns+` FROM conversions
+		WHERE ($1 = '' OR client_id = $1)
+			AND ($2 = '' OR source_currency = $2)
+			AND ($3 = '' OR target_currency = $3)
+			AND ($4::timestamptz IS NULL OR created_at >= $4)
+			AND ($5::timestamptz IS NULL OR created_at < $5)
+			AND ($6::timestamptz IS NULL OR (created_at, conversion_id) < ($6, $7))
+		ORDER BY created_at DESC, conversion_id DESC LIMIT $8`,
+		f.ClientID, f.Source, f.Target, from, until, afterTime, f.AfterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []ConversionRecord
+	for rows.Next() {
+		r, err := scanConversion(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	return records, rows.Err()
+}
+
+func (p *postgresLedger) Conversion(ctx context.Context, id string) (ConversionRecord, error) {
+	r, err := scanConversion(p.db.QueryRowContext(ctx, `SELECT `+conversionColumns+` FROM conversions
+		WHERE conversion_id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return r, fmt.Errorf("%w: %s", ErrConversionNotFound, id)
+	}
+	return r, err
+}
+
+// scanConversion reads a row selected with conversionColumns
+func scanConversion(row interface{ Scan(dest ...any) error }) (ConversionRecord, error) {
+	var r ConversionRecord
+	var request, response []byte
+	if err := row.Scan(&r.ID, &r.ClientID, &r.TraceID, &r.IdempotencyKey, &r.Source, &r.Target,
+		&r.Amount, &r.Converted, &r.Rate, &request, &response, &r.CreatedAt); err != nil {
+		return r, err
+	}
+	r.Request, r.Response = &pb.ConvertRequest{}, &pb.ConvertResponse{}
+	if err := proto.Unmarshal(request, r.Request); err != nil {
+		return r, err
+	}
+	return r, proto.Unmarshal(response, r.Response)
+}
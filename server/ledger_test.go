@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestConvertRecordedInLedger(t *testing.T) {
+	s := newTestServer()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		clientIDHeader, "wallet",
+		traceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	))
+
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"}
+	res, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.ConversionId)
+
+	records, err := s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		r := records[0]
+		assert.Equal(t, res.ConversionId, r.ID)
+		assert.Equal(t, "wallet", r.ClientID)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", r.TraceID)
+		assert.Equal(t, "USD", r.Source)
+		assert.Equal(t, "INR", r.Target)
+		assert.Equal(t, "100", r.Amount.String())
+		assert.Equal(t, "8312", r.Converted.String())
+		assert.Equal(t, "83.12", r.Rate.String())
+		assert.True(t, proto.Equal(req, r.Request))
+		assert.True(t, proto.Equal(res, r.Response))
+	}
+
+	// Failed conversions are not recorded
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "XYZ"})
+	assert.Error(t, err)
+	records, err = s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+}
+
+func TestConvertIdempotentRetryRecordedOnce(t *testing.T) {
+	s := newTestServer()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(clientIDHeader, "wallet", requestIDHeader, "req-1"))
+
+	req := &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-1"}
+	first, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	retry, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, first.ConversionId, retry.ConversionId)
+
+	records, err := s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "transfer-1", records[0].IdempotencyKey)
+		assert.Equal(t, "req-1", records[0].TraceID)
+	}
+}
+
+func TestExecutedQuoteRecordedInLedger(t *testing.T) {
+	s := newTestServer()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(clientIDHeader, "wallet"))
+
+	quote, err := s.CreateQuote(ctx, &pb.CreateQuoteRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_BUY})
+	assert.NoError(t, err)
+	// Quoting alone only prices the conversion
+	records, err := s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	s.store.(*memoryStore).Set("USD", decimal.RequireFromString("84"))
+	res, err := s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{QuoteId: quote.Quote.QuoteId})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.Conversion.ConversionId)
+	assert.Empty(t, res.Quote.Conversion.ConversionId)
+
+	records, err = s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		r := records[0]
+		assert.Equal(t, res.Conversion.ConversionId, r.ID)
+		assert.Equal(t, "wallet", r.ClientID)
+		// The quoted rate, not the one in force at execution
+		assert.Equal(t, "83.12", r.Rate.String())
+		assert.Equal(t, "8312", r.Converted.String())
+		assert.Equal(t, pb.Side_SIDE_BUY, r.Request.Side)
+		assert.Equal(t, int64(100), r.Request.AmountMoney.GetUnits())
+		assert.True(t, proto.Equal(res.Conversion, r.Response))
+	}
+}
+
+func TestConvertFailsWhenLedgerFails(t *testing.T) {
+	s := newTestServer()
+	s.ledger = failingLedger{}
+	idempotency := newMemoryIdempotencyStore(s.ledger)
+	s.idempotency = idempotency
+	ctx := context.Background()
+
+	_, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-1"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// The failed conversion released its key, so a retry converts again
+	idempotency.ledger = newMemoryLedger()
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR", IdempotencyKey: "transfer-1"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.ConversionId)
+}
+
+func TestExecuteQuoteFailsWhenLedgerFails(t *testing.T) {
+	s := newTestServer()
+	quotes := newMemoryQuoteStore(failingLedger{})
+	s.quotes = quotes
+	ctx := context.Background()
+	q := createTestQuote(t, ctx, s)
+
+	_, err := s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{QuoteId: q.QuoteId})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// The quote was not spent, so it can still be executed once the ledger works
+	quotes.ledger = s.ledger
+	res, err := s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{QuoteId: q.QuoteId})
+	assert.NoError(t, err)
+	records, err := s.ledger.ListConversions(ctx, ConversionFilter{}, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, res.Conversion.ConversionId, records[0].ID)
+	}
+}
+
+// failingLedger rejects every conversion
+type failingLedger struct {
+	Ledger
+}
+
+func (failingLedger) RecordConversion(context.Context, ConversionRecord) error {
+	return assert.AnError
+}
+
+// testLedgerServer returns a ConversionLedger over conversions made an hour apart, oldest first
+func testLedgerServer(t *testing.T, start time.Time) *ledgerServer {
+	ledger := newMemoryLedger()
+	for i, c := range []struct{ client, source, target string }{
+		{"wallet", "USD", "INR"},
+		{"wallet", "EUR", "INR"},
+		{"payroll", "USD", "INR"},
+		{"wallet", "USD", "JPY"},
+		{"wallet", "USD", "INR"},
+	} {
+		err := ledger.RecordConversion(context.Background(), ConversionRecord{
+			ID:        string(rune('a' + i)),
+			ClientID:  c.client,
+			Source:    c.source,
+			Target:    c.target,
+			Amount:    decimal.NewFromInt(100),
+			Request:   &pb.ConvertRequest{Amount: 100, SourceCurrency: c.source, TargetCurrency: c.target},
+			Response:  &pb.ConvertResponse{},
+			CreatedAt: start.Add(time.Duration(i) * time.Hour),
+		})
+		assert.NoError(t, err)
+	}
+	return newLedgerServer(ledger)
+}
+
+func conversionIDs(res *pb.ListConversionsResponse) []string {
+	var ids []string
+	for _, c := range res.GetConversions() {
+		ids = append(ids, c.ConversionId)
+	}
+	return ids
+}
+
+func TestListConversionsFilters(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	l := testLedgerServer(t, start)
+	ctx := clientContext(t, "auditor")
+
+	for _, tc := range []struct {
+		name string
+		req  *pb.ListConversionsRequest
+		want []string
+	}{
+		{"all", &pb.ListConversionsRequest{}, []string{"e", "d", "c", "b", "a"}},
+		{"client", &pb.ListConversionsRequest{ClientId: "wallet"}, []string{"e", "d", "b", "a"}},
+		{"pair", &pb.ListConversionsRequest{SourceCurrency: "USD", TargetCurrency: "INR"}, []string{"e", "c", "a"}},
+		{"target", &pb.ListConversionsRequest{TargetCurrency: "JPY"}, []string{"d"}},
+		{"time range", &pb.ListConversionsRequest{
+			StartTime: timestamppb.New(start.Add(time.Hour)),
+			EndTime:   timestamppb.New(start.Add(3 * time.Hour)),
+		}, []string{"c", "b"}},
+		{"combined", &pb.ListConversionsRequest{ClientId: "wallet", SourceCurrency: "USD", StartTime: timestamppb.New(start.Add(time.Hour))}, []string{"e", "d"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := l.ListConversions(ctx, tc.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, conversionIDs(res))
+			assert.Empty(t, res.NextPageToken)
+		})
+	}
+}
+
+func TestListConversionsPaging(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	l := testLedgerServer(t, start)
+	ctx := clientContext(t, "auditor")
+
+	var ids []string
+	req := &pb.ListConversionsRequest{ClientId: "wallet", PageSize: 3}
+	for {
+		res, err := l.ListConversions(ctx, req)
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(res.Conversions), 3)
+		ids = append(ids, conversionIDs(res)...)
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	assert.Equal(t, []string{"e", "d", "b", "a"}, ids)
+
+	// Conversions made at the same time are not skipped or repeated
+	ledger := newMemoryLedger()
+	for _, id := range []string{"x", "y", "z"} {
+		assert.NoError(t, ledger.RecordConversion(ctx, ConversionRecord{ID: id, CreatedAt: start}))
+	}
+	l = newLedgerServer(ledger)
+	first, err := l.ListConversions(ctx, &pb.ListConversionsRequest{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"z", "y"}, conversionIDs(first))
+	second, err := l.ListConversions(ctx, &pb.ListConversionsRequest{PageSize: 2, PageToken: first.NextPageToken})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"x"}, conversionIDs(second))
+}
+
+func TestListConversionsRejectsInvalidRequests(t *testing.T) {
+	l := testLedgerServer(t, time.Now())
+
+	_, err := l.ListConversions(context.Background(), &pb.ListConversionsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := clientContext(t, "auditor")
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		req   *pb.ListConversionsRequest
+		field string
+	}{
+		{&pb.ListConversionsRequest{SourceCurrency: "XYZ"}, "source_currency"},
+		{&pb.ListConversionsRequest{TargetCurrency: "usd"}, "target_currency"},
+		{&pb.ListConversionsRequest{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start)}, "end_time"},
+		{&pb.ListConversionsRequest{PageSize: -1}, "page_size"},
+		{&pb.ListConversionsRequest{PageToken: "not-a-token"}, "page_token"},
+		{&pb.ListConversionsRequest{PageToken: encodePageToken("no-cursor")}, "page_token"},
+	} {
+		_, err := l.ListConversions(ctx, tc.req)
+		assert.Equal(t, []string{tc.field}, violatedFields(t, err))
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+
+	pb "CurrencyConverter/proto"
+)
+
+// divisionPrecision is the number of decimal places kept when dividing by a rate
+const divisionPrecision = 18
+
+var nanosPerUnit = decimal.New(1, 9)
+
+// decimalFromMoney converts a Money message into an exact decimal
+func decimalFromMoney(m *pb.Money) (decimal.Decimal, error) {
+	units, nanos := m.GetUnits(), m.GetNanos()
+	if nanos <= -1e9 || nanos >= 1e9 {
+		return decimal.Zero, fmt.Errorf("nanos %d out of range", nanos)
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return decimal.Zero, errors.New("units and nanos must have the same sign")
+	}
+	return decimal.NewFromInt(units).Add(decimal.New(int64(nanos), -9)), nil
+}
+
+// moneyFromDecimal converts a decimal into a Money message, rounding to nanos
+func moneyFromDecimal(d decimal.Decimal, currency string) (*pb.Money, error) {
+	d = d.Round(9)
+	units := d.Truncate(0)
+	if units.GreaterThan(decimal.NewFromInt(math.MaxInt64)) || units.LessThan(decimal.NewFromInt(math.MinInt64)) {
+		return nil, fmt.Errorf("amount %s does not fit in money units", d)
+	}
+	nanos := d.Sub(units).Mul(nanosPerUnit)
+	return &pb.Money{
+		CurrencyCode: currency,
+		Units:        units.IntPart(),
+		Nanos:        int32(nanos.IntPart()),
+	}, nil
+}
+
+// requestAmount returns the amount to convert, preferring amount_money over the legacy double
+func requestAmount(req *pb.ConvertRequest) (decimal.Decimal, error) {
+	return amountOf(req.GetAmountMoney(), req.GetAmount(), req.GetSourceCurrency(), "source")
+}
+
+// amountOf returns m when set, checking it is in the named currency, and amount otherwise
+func amountOf(m *pb.Money, amount float64, currency, role string) (decimal.Decimal, error) {
+	if m != nil {
+		if m.GetCurrencyCode() != "" && m.GetCurrencyCode() != currency {
+			return decimal.Zero, fmt.Errorf("amount currency %s does not match %s currency %s", m.GetCurrencyCode(), role, currency)
+		}
+		return decimalFromMoney(m)
+	}
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return decimal.Zero, errors.New("amount must be a finite number")
+	}
+	return decimal.NewFromFloat(amount), nil
+}
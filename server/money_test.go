@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestDecimalFromMoney(t *testing.T) {
+	d, err := decimalFromMoney(&pb.Money{Units: 12, Nanos: 340000000})
+	assert.NoError(t, err)
+	assert.Equal(t, "12.34", d.String())
+
+	d, err = decimalFromMoney(&pb.Money{Units: -1, Nanos: -500000000})
+	assert.NoError(t, err)
+	assert.Equal(t, "-1.5", d.String())
+}
+
+func TestDecimalFromMoneyRejectsMixedSigns(t *testing.T) {
+	_, err := decimalFromMoney(&pb.Money{Units: 1, Nanos: -1})
+	assert.Error(t, err)
+
+	_, err = decimalFromMoney(&pb.Money{Nanos: 1e9})
+	assert.Error(t, err)
+}
+
+func TestMoneyFromDecimal(t *testing.T) {
+	m, err := moneyFromDecimal(decimal.RequireFromString("-7.0000000015"), "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", m.CurrencyCode)
+	assert.Equal(t, int64(-7), m.Units)
+	assert.Equal(t, int32(-2), m.Nanos)
+}
+
+func TestMoneyFromDecimalOverflow(t *testing.T) {
+	_, err := moneyFromDecimal(decimal.RequireFromString("1e30"), "USD")
+	assert.Error(t, err)
+}
+
+func TestRequestAmountPrefersMoney(t *testing.T) {
+	amount, err := requestAmount(&pb.ConvertRequest{
+		Amount:         1,
+		SourceCurrency: "USD",
+		AmountMoney:    &pb.Money{CurrencyCode: "USD", Units: 10, Nanos: 100000000},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "10.1", amount.String())
+
+	_, err = requestAmount(&pb.ConvertRequest{
+		SourceCurrency: "USD",
+		AmountMoney:    &pb.Money{CurrencyCode: "EUR", Units: 10},
+	})
+	assert.Error(t, err)
+}
+
+func TestRequestAmountFromDouble(t *testing.T) {
+	amount, err := requestAmount(&pb.ConvertRequest{Amount: 0.1})
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", amount.String())
+}
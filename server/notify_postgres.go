@@ -0,0 +1,51 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ratesChangedChannel is the NOTIFY channel the rate history triggers publish to
+const ratesChangedChannel = "rates_changed"
+
+// pgNotifier turns PostgreSQL notifications on ratesChangedChannel into change signals
+type pgNotifier struct {
+	listener *pq.Listener
+	changes  chan struct{}
+}
+
+func newPgNotifier(connString string) (*pgNotifier, error) {
+	listener := pq.NewListener(connString, time.Second, time.Minute, func(_ pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("rate change listener: %v", err)
+		}
+	})
+	if err := listener.Listen(ratesChangedChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	n := &pgNotifier{listener: listener, changes: make(chan struct{}, 1)}
+	go n.forward()
+	return n, nil
+}
+
+// forward signals a change for every notification. The listener also sends nil after reconnecting,
+// when notifications may have been missed, which is treated as a change too.
+func (n *pgNotifier) forward() {
+	for range n.listener.Notify {
+		select {
+		case n.changes <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (n *pgNotifier) Changes() <-chan struct{} {
+	return n.changes
+}
+
+func (n *pgNotifier) Close() error {
+	return n.listener.Close()
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// pageSize applies the default and upper bound to a requested page size
+func pageSize(requested int32) (int, error) {
+	switch {
+	case requested < 0:
+		return 0, errors.New("page_size must not be negative")
+	case requested == 0:
+		return defaultPageSize, nil
+	case requested > maxPageSize:
+		return maxPageSize, nil
+	}
+	return int(requested), nil
+}
+
+// encodePageToken wraps the key of the last item on a page in an opaque token
+func encodePageToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// decodePageToken returns the key a page token continues after; an empty token starts at the beginning
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(key) == 0 {
+		return "", errors.New("invalid page_token")
+	}
+	return string(key), nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	pb "CurrencyConverter/proto"
+)
+
+var (
+	// ErrQuoteNotFound is returned by a QuoteStore for an unknown quote ID
+	ErrQuoteNotFound = errors.New("quote not found")
+	// ErrQuoteExpired is returned when executing a quote after its expiry
+	ErrQuoteExpired = errors.New("quote expired")
+	// ErrQuoteExecuted is returned when executing a quote that was already executed
+	ErrQuoteExecuted = errors.New("quote already executed")
+)
+
+// Quote is a conversion priced at a locked rate that can be executed once before it expires
+type Quote struct {
+	ID     string
+	Source string
+	Target string
+	Amount decimal.Decimal
+	// Rate is the effective rate the conversion was priced at
+	Rate decimal.Decimal
+	// Conversion is the conversion as quoted, returned again on execution
+	Conversion *pb.ConvertResponse
+	// ClientID is the identity of the caller that created the quote, if any
+	ClientID   string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	ExecutedAt time.Time
+}
+
+// QuoteStore persists quotes
+type QuoteStore interface {
+	CreateQuote(ctx context.Context, q Quote) error
+	// ExecuteQuote marks the quote executed at now and records the conversion record describes for
+	// it in the ledger, both or neither, and returns the executed quote. A quote created by an
+	// identified client can only be executed by that client and is otherwise reported as not found.
+	// It fails with ErrQuoteNotFound, ErrQuoteExpired or ErrQuoteExecuted when the quote cannot be executed.
+	ExecuteQuote(ctx context.Context, id, clientID string, now time.Time, record func(Quote) (ConversionRecord, error)) (Quote, error)
+}
+
+// memoryQuoteStore keeps quotes in memory, for tests and local development. Executed quotes are
+// recorded in ledger while the store is locked.
+type memoryQuoteStore struct {
+	ledger Ledger
+
+	mu     sync.Mutex
+	quotes map[string]Quote
+}
+
+func newMemoryQuoteStore(ledger Ledger) *memoryQuoteStore {
+	return &memoryQuoteStore{ledger: ledger, quotes: make(map[string]Quote)}
+}
+
+func (m *memoryQuoteStore) CreateQuote(ctx context.Context, q Quote) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.quotes[q.ID]; ok {
+		return fmt.Errorf("quote %s already exists", q.ID)
+	}
+	m.quotes[q.ID] = q
+	return nil
+}
+
+func (m *memoryQuoteStore) ExecuteQuote(ctx context.Context, id, clientID string, now time.Time, record func(Quote) (ConversionRecord, error)) (Quote, error) {
+	if err := ctx.Err(); err != nil {
+		return Quote{}, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	q, ok := m.quotes[id]
+	switch {
+	case !ok || q.ClientID != "" && q.ClientID != clientID:
+		return Quote{}, ErrQuoteNotFound
+	case !q.ExecutedAt.IsZero():
+		return q, ErrQuoteExecuted
+	case !now.Before(q.ExpiresAt):
+		return q, ErrQuoteExpired
+	}
+	q.ExecutedAt = now
+	rec, err := record(q)
+	if err != nil {
+		return Quote{}, err
+	}
+	if err := m.ledger.RecordConversion(ctx, rec); err != nil {
+		return Quote{}, err
+	}
+	m.quotes[id] = q
+	return q, nil
+}
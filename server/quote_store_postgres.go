@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "CurrencyConverter/proto"
+)
+
+// postgresQuoteStore keeps quotes in the conversion_quotes table so they survive a restart
+type postgresQuoteStore struct {
+	db *sql.DB
+}
+
+func newPostgresQuoteStore(db *sql.DB) *postgresQuoteStore {
+	return &postgresQuoteStore{db: db}
+}
+
+func (p *postgresQuoteStore) CreateQuote(ctx context.Context, q Quote) error {
+	conversion, err := proto.Marshal(q.Conversion)
+	if err != nil {
+		return err
+	}
+	_, err = p.db.ExecContext(ctx, `INSERT INTO conversion_quotes
+		(quote_id, source_currency, target_currency, amount, rate, conversion, client_id, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		q.ID, q.Source, q.Target, q.Amount, q.Rate, conversion, q.ClientID, q.CreatedAt, q.ExpiresAt)
+	return err
+}
+
+func (p *postgresQuoteStore) ExecuteQuote(ctx context.Context, id, clientID string, now time.Time, record func(Quote) (ConversionRecord, error)) (Quote, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Quote{}, err
+	}
+	defer tx.Rollback()
+
+	// The conditional update lets exactly one concurrent execution succeed
+	q, err := p.scanQuote(tx.QueryRowContext(ctx, `UPDATE conversion_quotes SET executed_at = $2
+		WHERE quote_id = $1 AND (client_id = '' OR client_id = $3) AND executed_at IS NULL AND expires_at > $2
+		RETURNING `+quoteColumns, id, now, clientID))
+	if err == nil {
+		// The quote stays unexecuted unless its conversion is recorded too
+		rec, err := record(q)
+		if err != nil {
+			return Quote{}, err
+		}
+		if err := insertConversion(ctx, tx, rec); err != nil {
+			return Quote{}, err
+		}
+		return q, tx.Commit()
+	}
+	if !errors.Is(err, ErrQuoteNotFound) {
+		return Quote{}, err
+	}
+
+	// Find out why the quote could not be executed
+	q, err = p.scanQuote(p.db.QueryRowContext(ctx, `SELECT `+quoteColumns+` FROM conversion_quotes
+		WHERE quote_id = $1 AND (client_id = '' OR client_id = $2)`, id, clientID))
+	if err != nil {
+		return Quote{}, err
+	}
+	if !q.ExecutedAt.IsZero() {
+		return q, ErrQuoteExecuted
+	}
+	return q, ErrQuoteExpired
+}
+
+const quoteColumns = "quote_id, source_currency, target_currency, amount, rate, conversion, client_id, created_at, expires_at, executed_at"
+
+func (p *postgresQuoteStore) scanQuote(row *sql.Row) (Quote, error) {
+	var q Quote
+	var conversion []byte
+	var executedAt sql.NullTime
+	err := row.Scan(&q.ID, &q.Source, &q.Target, &q.Amount, &q.Rate, &conversion, &q.ClientID, &q.CreatedAt, &q.ExpiresAt, &executedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Quote{}, ErrQuoteNotFound
+	}
+	if err != nil {
+		return Quote{}, err
+	}
+	q.ExecutedAt = executedAt.Time
+	q.Conversion = &pb.ConvertResponse{}
+	if err := proto.Unmarshal(conversion, q.Conversion); err != nil {
+		return Quote{}, err
+	}
+	return q, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// defaultQuoteTTL is how long a quote's rate stays locked unless configured otherwise
+const defaultQuoteTTL = 30 * time.Second
+
+// CreateQuote implements the gRPC method pricing a conversion at a rate locked until the quote expires
+func (s *server) CreateQuote(ctx context.Context, req *pb.CreateQuoteRequest) (*pb.CreateQuoteResponse, error) {
+	params, err := s.policy.validateConvertRequest(&pb.ConvertRequest{
+		Amount:         req.GetAmount(),
+		SourceCurrency: req.GetSourceCurrency(),
+		TargetCurrency: req.GetTargetCurrency(),
+		AmountMoney:    req.GetAmountMoney(),
+		RoundingMode:   req.GetRoundingMode(),
+		Side:           req.GetSide(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	conversion, rt, err := s.convert(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newRandomID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create quote ID: %v", err)
+	}
+	now := time.Now()
+	q := Quote{
+		ID:         id,
+		Source:     params.source.Code,
+		Target:     params.target.Code,
+		Amount:     params.amount,
+		Rate:       rt.rate(),
+		Conversion: conversion,
+		ClientID:   callerIdentity(ctx),
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(s.quoteTTL),
+	}
+	if err := s.quotes.CreateQuote(ctx, q); err != nil {
+		log.Printf("Error storing quote %s: %v", id, err)
+		return nil, quoteError(id, err)
+	}
+	return &pb.CreateQuoteResponse{Quote: quoteProto(q)}, nil
+}
+
+// ExecuteQuote implements the gRPC method converting at a quote's locked rate
+func (s *server) ExecuteQuote(ctx context.Context, req *pb.ExecuteQuoteRequest) (*pb.ExecuteQuoteResponse, error) {
+	id := req.GetQuoteId()
+	if id == "" {
+		return nil, invalidArgumentError(fieldViolation("quote_id", errors.New("quote_id is required")))
+	}
+
+	conversionID, err := newConversionID()
+	if err != nil {
+		return nil, err
+	}
+	// The executed conversion is recorded like one made by Convert, with the request the quote
+	// priced, in the same transaction that spends the quote
+	var conversion *pb.ConvertResponse
+	q, err := s.quotes.ExecuteQuote(ctx, id, callerIdentity(ctx), time.Now(), func(q Quote) (ConversionRecord, error) {
+		conversion = proto.Clone(q.Conversion).(*pb.ConvertResponse)
+		amount, _ := moneyFromDecimal(q.Amount, q.Source)
+		priced := &pb.ConvertRequest{
+			SourceCurrency: q.Source,
+			TargetCurrency: q.Target,
+			AmountMoney:    amount,
+			RoundingMode:   conversion.GetRoundingMode(),
+			Side:           conversion.GetSide(),
+		}
+		return completeRecord(ctx, conversionID, ConversionRecord{Source: q.Source, Target: q.Target, Amount: q.Amount, Rate: q.Rate, Request: priced}, conversion)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrQuoteNotFound) && !errors.Is(err, ErrQuoteExpired) && !errors.Is(err, ErrQuoteExecuted) {
+			log.Printf("Error executing quote %s as conversion %s: %v", id, conversionID, err)
+		}
+		return nil, quoteError(id, err)
+	}
+	return &pb.ExecuteQuoteResponse{
+		Quote:      quoteProto(q),
+		Conversion: conversion,
+		ExecutedAt: timestamppb.New(q.ExecutedAt),
+	}, nil
+}
+
+// newRandomID returns a random, unguessable ID for a quote or conversion
+func newRandomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// quoteProto describes a quote for a response
+func quoteProto(q Quote) *pb.Quote {
+	res := &pb.Quote{
+		QuoteId:        q.ID,
+		SourceCurrency: q.Source,
+		TargetCurrency: q.Target,
+		Rate:           q.Rate.String(),
+		Conversion:     q.Conversion,
+		CreatedAt:      timestamppb.New(q.CreatedAt),
+		ExpiresAt:      timestamppb.New(q.ExpiresAt),
+	}
+	// The amount passed validation, so it fits in Money
+	res.Amount, _ = moneyFromDecimal(q.Amount, q.Source)
+	return res
+}
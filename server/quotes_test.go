@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+func createTestQuote(t *testing.T, ctx context.Context, s *server) *pb.Quote {
+	t.Helper()
+	res, err := s.CreateQuote(ctx, &pb.CreateQuoteRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.Quote
+}
+
+func preconditionType(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if pf, ok := d.(*errdetails.PreconditionFailure); ok && len(pf.Violations) > 0 {
+			return pf.Violations[0].Type
+		}
+	}
+	return ""
+}
+
+func TestQuoteLocksRate(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	q := createTestQuote(t, ctx, s)
+	assert.Len(t, q.QuoteId, 32)
+	assert.Equal(t, "83.12", q.Rate)
+	assert.Equal(t, int64(100), q.Amount.Units)
+	assert.Equal(t, defaultQuoteTTL, q.ExpiresAt.AsTime().Sub(q.CreatedAt.AsTime()))
+
+	// The stored rate changes between quoting and executing
+	s.store.(*memoryStore).Set("USD", decimal.RequireFromString("84"))
+
+	res, err := s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{QuoteId: q.QuoteId})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8312), res.Conversion.ConvertedMoney.Units)
+	assert.Equal(t, "8312", res.Conversion.UnroundedAmount)
+	assert.NotNil(t, res.ExecutedAt)
+}
+
+func TestQuoteIsSingleUse(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	q := createTestQuote(t, ctx, s)
+
+	var wg sync.WaitGroup
+	results := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{QuoteId: q.QuoteId})
+			results <- err
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	succeeded := 0
+	for err := range results {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, "QUOTE_EXECUTED", preconditionType(err))
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestQuoteExpires(t *testing.T) {
+	s := newTestServer()
+	s.quoteTTL = -time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	q := createTestQuote(t, ctx, s)
+
+	_, err := s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{QuoteId: q.QuoteId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "QUOTE_EXPIRED", preconditionType(err))
+}
+
+func TestQuoteBelongsToClient(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	alice := metadata.NewIncomingContext(ctx, metadata.Pairs(clientIDHeader, "alice"))
+	mallory := metadata.NewIncomingContext(ctx, metadata.Pairs(clientIDHeader, "mallory"))
+	q := createTestQuote(t, alice, s)
+
+	_, err := s.ExecuteQuote(mallory, &pb.ExecuteQuoteRequest{QuoteId: q.QuoteId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.ExecuteQuote(alice, &pb.ExecuteQuoteRequest{QuoteId: q.QuoteId})
+	assert.NoError(t, err)
+}
+
+func TestQuoteErrors(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.ExecuteQuote(ctx, &pb.ExecuteQuoteRequest{QuoteId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.CreateQuote(ctx, &pb.CreateQuoteRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "GBP"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.CreateQuote(ctx, &pb.CreateQuoteRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "XYZ"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// GetRate implements the gRPC method returning the effective rate between two currencies
+func (s *server) GetRate(ctx context.Context, req *pb.GetRateRequest) (*pb.GetRateResponse, error) {
+	params, err := validateGetRateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := s.resolveRoute(ctx, params.source.Code, params.target.Code, params.asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return pairRate(params.source.Code, params.target.Code, rt), nil
+}
+
+// pairRate describes the effective rate of a route between two currencies
+func pairRate(sourceCurrency, targetCurrency string, rt route) *pb.GetRateResponse {
+	res := &pb.GetRateResponse{
+		SourceCurrency: sourceCurrency,
+		TargetCurrency: targetCurrency,
+		Rate:           rt.rate().String(),
+		InverseRate:    rt.inverseRate().String(),
+		Provider:       strings.Join(rt.providers(), ","),
+		Route:          rt.kind,
+		Path:           rt.path(),
+		AppliedRates:   rt.appliedRates(),
+		Bid:            rt.withSide(pb.Side_SIDE_BUY).rate().String(),
+		Ask:            rt.withSide(pb.Side_SIDE_SELL).rate().String(),
+	}
+	if updated := rt.updatedAt(); !updated.IsZero() {
+		res.UpdatedAt = timestamppb.New(updated)
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestGetRatePivot(t *testing.T) {
+	s := newTestServer()
+	updated := time.Now().Add(-2 * time.Minute).UTC()
+	s.store.(*memoryStore).Put(Rate{Base: "USD", Quote: "INR", Value: decimal.RequireFromString("83.12"), EffectiveFrom: updated, Provider: "rbi"})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.GetRate(ctx, &pb.GetRateRequest{SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, "83.12", res.Rate)
+	assert.Equal(t, "0.012030798845043311", res.InverseRate)
+	assert.Equal(t, updated, res.UpdatedAt.AsTime())
+	assert.Equal(t, "rbi", res.Provider)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_PIVOT, res.Route)
+	assert.Equal(t, []string{"USD", "INR"}, res.Path)
+}
+
+func TestGetRateInverseIsExact(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.GetRate(ctx, &pb.GetRateRequest{SourceCurrency: "EUR", TargetCurrency: "USD"})
+	assert.NoError(t, err)
+	// 90.45 / 83.12 and 83.12 / 90.45, each rounded once
+	assert.Equal(t, "1.088185755534167469", res.Rate)
+	assert.Equal(t, "0.918960751796572692", res.InverseRate)
+	assert.Equal(t, "manual", res.Provider)
+}
+
+func TestGetRateSameCurrency(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.GetRate(ctx, &pb.GetRateRequest{SourceCurrency: "JPY", TargetCurrency: "JPY"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", res.Rate)
+	assert.Equal(t, "1", res.InverseRate)
+	assert.Nil(t, res.UpdatedAt)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_IDENTITY, res.Route)
+}
+
+func TestGetRateErrors(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.GetRate(ctx, &pb.GetRateRequest{SourceCurrency: "USD", TargetCurrency: "XYZ"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.GetRate(ctx, &pb.GetRateRequest{SourceCurrency: "USD", TargetCurrency: "GBP"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetRateBidAsk(t *testing.T) {
+	s := newSpreadTestServer()
+
+	res, err := s.GetRate(context.Background(), &pb.GetRateRequest{SourceCurrency: "EUR", TargetCurrency: "USD"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.084814992791926958", res.Bid)
+	assert.Equal(t, "1.091566265060240964", res.Ask)
+	assert.True(t, decimal.RequireFromString(res.Bid).LessThan(decimal.RequireFromString(res.Rate)))
+
+	// Without a published bid and ask both equal the mid rate
+	res, err = s.GetRate(context.Background(), &pb.GetRateRequest{SourceCurrency: "JPY", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Rate, res.Bid)
+	assert.Equal(t, res.Rate, res.Ask)
+}
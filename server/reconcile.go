@@ -0,0 +1,443 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+// walletTransaction is a conversion exported by a wallet, checked against the rates in force when it was made
+type walletTransaction struct {
+	// line is where the transaction was read from, for the report
+	line      int
+	ID        string
+	Timestamp time.Time
+	ClientID  string
+	Source    string
+	Target    string
+	Amount    decimal.Decimal
+	Converted decimal.Decimal
+	Side      pb.Side
+	// RoundingMode is unspecified, meaning the default, unless the export names one
+	RoundingMode pb.RoundingMode
+	// ConversionID identifies the conversion in the ledger, when the wallet kept it
+	ConversionID string
+}
+
+// requiredTransactionFields must be present in every exported transaction; client_id, side,
+// rounding_mode and conversion_id are optional
+var requiredTransactionFields = []string{"id", "timestamp", "source_currency", "target_currency", "amount", "converted_amount"}
+
+// readTransactions reads a wallet export in the csv format, with a header row naming the fields,
+// or the jsonl format, with one JSON object per line. Fields it does not know are ignored.
+func readTransactions(r io.Reader, format string) ([]walletTransaction, error) {
+	switch format {
+	case "csv":
+		return readCSVTransactions(r)
+	case "jsonl":
+		return readJSONLTransactions(r)
+	default:
+		return nil, fmt.Errorf("unknown export format %q, want csv or jsonl", format)
+	}
+}
+
+func readCSVTransactions(r io.Reader) ([]walletTransaction, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var txs []walletTransaction
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return txs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		fields := make(map[string]string, len(header))
+		for i, name := range header {
+			fields[name] = record[i]
+		}
+		tx, err := parseTransaction(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		tx.line = line
+		txs = append(txs, tx)
+	}
+}
+
+func readJSONLTransactions(r io.Reader) ([]walletTransaction, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	var txs []walletTransaction
+	for line := 1; sc.Scan(); line++ {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		var values map[string]any
+		dec := json.NewDecoder(strings.NewReader(sc.Text()))
+		// Keep amounts exact instead of reading them as float64
+		dec.UseNumber()
+		if err := dec.Decode(&values); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		fields := make(map[string]string, len(values))
+		for name, v := range values {
+			switch v := v.(type) {
+			case string:
+				fields[name] = v
+			case json.Number:
+				fields[name] = v.String()
+			case nil:
+			default:
+				return nil, fmt.Errorf("line %d: %s must be a string or a number", line, name)
+			}
+		}
+		tx, err := parseTransaction(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		tx.line = line
+		txs = append(txs, tx)
+	}
+	return txs, sc.Err()
+}
+
+// parseTransaction reads the fields of an exported transaction. Currencies are checked when it is
+// reconciled, so an unknown one is reported like any other conversion that cannot be repeated.
+func parseTransaction(fields map[string]string) (walletTransaction, error) {
+	for _, name := range requiredTransactionFields {
+		if strings.TrimSpace(fields[name]) == "" {
+			return walletTransaction{}, fmt.Errorf("missing %s", name)
+		}
+	}
+	tx := walletTransaction{
+		ID:           strings.TrimSpace(fields["id"]),
+		ClientID:     strings.TrimSpace(fields["client_id"]),
+		Source:       strings.ToUpper(strings.TrimSpace(fields["source_currency"])),
+		Target:       strings.ToUpper(strings.TrimSpace(fields["target_currency"])),
+		ConversionID: strings.TrimSpace(fields["conversion_id"]),
+	}
+	var err error
+	if tx.Timestamp, err = time.Parse(time.RFC3339Nano, strings.TrimSpace(fields["timestamp"])); err != nil {
+		return tx, fmt.Errorf("timestamp must be RFC 3339: %w", err)
+	}
+	if tx.Amount, err = decimal.NewFromString(strings.TrimSpace(fields["amount"])); err != nil {
+		return tx, fmt.Errorf("invalid amount: %w", err)
+	}
+	if tx.Converted, err = decimal.NewFromString(strings.TrimSpace(fields["converted_amount"])); err != nil {
+		return tx, fmt.Errorf("invalid converted_amount: %w", err)
+	}
+	if side := strings.ToUpper(strings.TrimSpace(fields["side"])); side != "" {
+		v, ok := pb.Side_value["SIDE_"+side]
+		if !ok {
+			return tx, fmt.Errorf("side must be buy or sell, got %q", fields["side"])
+		}
+		tx.Side = pb.Side(v)
+	}
+	if mode := strings.ToUpper(strings.TrimSpace(fields["rounding_mode"])); mode != "" {
+		v, ok := pb.RoundingMode_value["ROUNDING_MODE_"+strings.TrimPrefix(mode, "ROUNDING_MODE_")]
+		if !ok || v == int32(pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED) {
+			return tx, fmt.Errorf("rounding_mode must be half_even, half_up, down, up, ceiling or floor, got %q", fields["rounding_mode"])
+		}
+		tx.RoundingMode = pb.RoundingMode(v)
+	}
+	return tx, nil
+}
+
+// reconciledTransaction is an exported transaction with the amount it should have converted to
+type reconciledTransaction struct {
+	walletTransaction
+	expected decimal.Decimal
+	// err is why the conversion could not be repeated
+	err error
+}
+
+func (r reconciledTransaction) difference() decimal.Decimal {
+	return r.Converted.Sub(r.expected)
+}
+
+// currencyTotals sums the reconciled transactions into one target currency. Converted and
+// expected only include transactions that could be repeated.
+type currencyTotals struct {
+	transactions, mismatches, failed int
+	converted, expected              decimal.Decimal
+}
+
+// reconciliation is the outcome of checking a wallet export
+type reconciliation struct {
+	tolerance    decimal.Decimal
+	transactions int
+	mismatches   []reconciledTransaction
+	failed       []reconciledTransaction
+	totals       map[string]*currencyTotals
+}
+
+// reconcile checks every exported conversion against the one recorded in the ledger or, without a
+// conversion ID, repeats it at the rates in force at its timestamp, applying the configured fee
+// rules, and collects those whose converted amount differs by more than tolerance
+func (s *server) reconcile(ctx context.Context, txs []walletTransaction, tolerance decimal.Decimal) reconciliation {
+	rec := reconciliation{tolerance: tolerance, transactions: len(txs), totals: map[string]*currencyTotals{}}
+	for _, tx := range txs {
+		r := reconciledTransaction{walletTransaction: tx}
+		r.expected, r.err = s.expectedConversion(ctx, tx)
+
+		totals := rec.totals[tx.Target]
+		if totals == nil {
+			totals = &currencyTotals{}
+			rec.totals[tx.Target] = totals
+		}
+		totals.transactions++
+		switch {
+		case r.err != nil:
+			totals.failed++
+			rec.failed = append(rec.failed, r)
+			continue
+		case r.difference().Abs().GreaterThan(tolerance):
+			totals.mismatches++
+			rec.mismatches = append(rec.mismatches, r)
+		}
+		totals.converted = totals.converted.Add(tx.Converted)
+		totals.expected = totals.expected.Add(r.expected)
+	}
+	return rec
+}
+
+// expectedConversion returns what a transaction should have converted to: the amount recorded in
+// the ledger for its conversion ID, or otherwise the amount Convert would have returned at its
+// timestamp, including the amount policy's limits
+func (s *server) expectedConversion(ctx context.Context, tx walletTransaction) (decimal.Decimal, error) {
+	if tx.ConversionID != "" {
+		return s.recordedConversion(ctx, tx)
+	}
+	if err := s.policy.checkAmount(tx.Amount); err != nil {
+		return decimal.Zero, err
+	}
+	params := convertParams{
+		amount:      tx.Amount,
+		amountField: "amount",
+		asOf:        tx.Timestamp,
+		side:        tx.Side,
+		client:      tx.ClientID,
+	}
+	var err error
+	if params.roundingMode, err = resolveRoundingMode(tx.RoundingMode); err != nil {
+		return decimal.Zero, err
+	}
+	if params.source, err = lookupCurrency(tx.Source); err != nil {
+		return decimal.Zero, err
+	}
+	if params.target, err = lookupCurrency(tx.Target); err != nil {
+		return decimal.Zero, err
+	}
+	converted, err := s.convertCurrency(ctx, params.amount, params.source.Code, params.target.Code, params.asOf, params.side)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	res, err := s.convertResponse(params, converted)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return decimalFromMoney(res.GetConvertedMoney())
+}
+
+// recordedConversion returns the converted amount the ledger recorded for a transaction's conversion,
+// provided it was a conversion of the same amount between the same currencies
+func (s *server) recordedConversion(ctx context.Context, tx walletTransaction) (decimal.Decimal, error) {
+	r, err := s.ledger.Conversion(ctx, tx.ConversionID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if r.Source != tx.Source || r.Target != tx.Target || !r.Amount.Equal(tx.Amount) {
+		return decimal.Zero, fmt.Errorf("conversion %s was of %s %s to %s", r.ID, r.Amount, r.Source, r.Target)
+	}
+	return decimalFromMoney(r.Response.GetConvertedMoney())
+}
+
+// ok reports whether every transaction was converted within the tolerance
+func (rec reconciliation) ok() bool {
+	return len(rec.mismatches) == 0 && len(rec.failed) == 0
+}
+
+// write prints the mismatches, the transactions that could not be checked and the totals per currency
+func (rec reconciliation) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if len(rec.mismatches) > 0 {
+		fmt.Fprintln(tw, "MISMATCHES")
+		fmt.Fprintln(tw, "LINE\tID\tTIMESTAMP\tPAIR\tAMOUNT\tCONVERTED\tEXPECTED\tDIFFERENCE")
+		for _, r := range rec.mismatches {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s/%s\t%s\t%s\t%s\t%s\n", r.line, r.ID, r.Timestamp.Format(time.RFC3339),
+				r.Source, r.Target, r.Amount, r.Converted, r.expected, r.difference())
+		}
+		fmt.Fprintln(tw)
+	}
+	if len(rec.failed) > 0 {
+		fmt.Fprintln(tw, "FAILED")
+		fmt.Fprintln(tw, "LINE\tID\tTIMESTAMP\tPAIR\tERROR")
+		for _, r := range rec.failed {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s/%s\t%s\n", r.line, r.ID, r.Timestamp.Format(time.RFC3339),
+				r.Source, r.Target, reconcileErrorMessage(r.err))
+		}
+		fmt.Fprintln(tw)
+	}
+
+	currencies := make([]string, 0, len(rec.totals))
+	for c := range rec.totals {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+	fmt.Fprintln(tw, "CURRENCY\tTRANSACTIONS\tMISMATCHES\tFAILED\tCONVERTED\tEXPECTED\tDIFFERENCE")
+	for _, c := range currencies {
+		t := rec.totals[c]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%s\t%s\n", c, t.transactions, t.mismatches, t.failed,
+			t.converted, t.expected, t.converted.Sub(t.expected))
+	}
+	fmt.Fprintf(tw, "\n%d transactions, %d mismatches beyond %s, %d failed\n",
+		rec.transactions, len(rec.mismatches), rec.tolerance, len(rec.failed))
+	return tw.Flush()
+}
+
+// reconcileErrorMessage drops the gRPC status wrapping from an error
+func reconcileErrorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
+
+// exportFormat picks the format of an export from its file extension
+func exportFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv"
+	case ".jsonl", ".ndjson":
+		return "jsonl"
+	}
+	return ""
+}
+
+// forwardConfigFlags accepts the server's configuration flags on fs and collects them, as they
+// were given, to be passed on to loadConfig
+func forwardConfigFlags(fs *flag.FlagSet) *[]string {
+	var args []string
+	forward := func(name string) func(string) error {
+		return func(v string) error {
+			args = append(args, "-"+name+"="+v)
+			return nil
+		}
+	}
+	fs.Func("config", "path to a YAML config file", forward("config"))
+	for _, st := range settings {
+		if st.flag != "" {
+			fs.Func(st.flag, st.usage, forward(st.flag))
+		}
+	}
+	return &args
+}
+
+// runReconcile implements the reconcile subcommand, returning the process exit code: 0 when every
+// transaction matches, 1 when some do not or could not be checked and 2 when reconciling failed
+func runReconcile(args []string, stdout io.Writer) int {
+	fs := flag.NewFlagSet("currency-converter reconcile", flag.ContinueOnError)
+	cfgArgs := forwardConfigFlags(fs)
+	format := fs.String("format", "", "export format, csv or jsonl (default from the file extension)")
+	toleranceFlag := fs.String("tolerance", "0.01", "largest accepted difference from the expected converted amount")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: currency-converter reconcile [flags] <export.csv|export.jsonl|->")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	tolerance, err := decimal.NewFromString(*toleranceFlag)
+	if err != nil || tolerance.IsNegative() {
+		log.Printf("tolerance must be a non-negative decimal, got %q", *toleranceFlag)
+		return 2
+	}
+	path := fs.Arg(0)
+	if *format == "" {
+		*format = exportFormat(path)
+	}
+
+	cfg, err := loadConfig(*cfgArgs, os.Getenv)
+	if err != nil {
+		log.Printf("failed to load configuration: %v", err)
+		return 2
+	}
+
+	in := os.Stdin
+	if path != "-" {
+		if in, err = os.Open(path); err != nil {
+			log.Printf("failed to open export: %v", err)
+			return 2
+		}
+		defer in.Close()
+	}
+	txs, err := readTransactions(in, *format)
+	if err != nil {
+		log.Printf("failed to read export %s: %v", path, err)
+		return 2
+	}
+
+	db, err := initDB(cfg.Database)
+	if err != nil {
+		log.Printf("failed to connect to database: %v", err)
+		return 2
+	}
+	defer db.Close()
+	srv := newServer(newPostgresStore(db, cfg.BaseCurrency))
+	srv.policy = cfg.amountPolicy()
+	srv.paths = cfg.Routing.pathOptions()
+	srv.fees = cfg.Fees.Rules
+	srv.ledger = newPostgresLedger(db)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	rec := srv.reconcile(ctx, txs, tolerance)
+	if err := ctx.Err(); err != nil {
+		log.Printf("reconciliation interrupted: %v", err)
+		return 2
+	}
+	if err := rec.write(stdout); err != nil {
+		log.Printf("failed to write report: %v", err)
+		return 2
+	}
+	if !rec.ok() {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	pb "CurrencyConverter/proto"
+)
+
+const testExportCSV = `id,timestamp,client_id,source_currency,target_currency,amount,converted_amount,side,memo
+a,2024-02-01T10:00:00Z,wallet,USD,INR,100,8312,,before the rate change
+b,2024-03-15T10:00:00Z,wallet,USD,INR,100,8312,,stale rate
+c,2024-03-15T10:00:00+05:30,wallet,usd,inr,100,8000.01,,
+d,2024-03-15T10:00:00Z,,EUR,USD,100,113.06,buy,
+e,2024-03-15T10:00:00Z,,USD,XYZ,100,1,,
+`
+
+const testExportJSONL = `{"id": "a", "timestamp": "2024-02-01T10:00:00Z", "client_id": "wallet", "source_currency": "USD", "target_currency": "INR", "amount": 100, "converted_amount": "8312", "memo": "before the rate change"}
+{"id": "b", "timestamp": "2024-03-15T10:00:00Z", "client_id": "wallet", "source_currency": "USD", "target_currency": "INR", "amount": 100, "converted_amount": 8312}
+
+{"id": "c", "timestamp": "2024-03-15T10:00:00+05:30", "client_id": "wallet", "source_currency": "usd", "target_currency": "inr", "amount": "100", "converted_amount": 8000.01, "side": null}
+{"id": "d", "timestamp": "2024-03-15T10:00:00Z", "source_currency": "EUR", "target_currency": "USD", "amount": 100, "converted_amount": 113.06, "side": "BUY"}
+{"id": "e", "timestamp": "2024-03-15T10:00:00Z", "source_currency": "USD", "target_currency": "XYZ", "amount": 100, "converted_amount": 1}
+`
+
+func TestReadTransactions(t *testing.T) {
+	for _, format := range []string{"csv", "jsonl"} {
+		t.Run(format, func(t *testing.T) {
+			export := testExportCSV
+			if format == "jsonl" {
+				export = testExportJSONL
+			}
+			txs, err := readTransactions(strings.NewReader(export), format)
+			assert.NoError(t, err)
+			if !assert.Len(t, txs, 5) {
+				return
+			}
+			assert.Equal(t, "a", txs[0].ID)
+			assert.Equal(t, "wallet", txs[0].ClientID)
+			assert.Equal(t, time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC), txs[0].Timestamp)
+			assert.Equal(t, "8312", txs[0].Converted.String())
+			assert.Equal(t, "USD", txs[2].Source)
+			assert.Equal(t, "INR", txs[2].Target)
+			assert.Equal(t, "8000.01", txs[2].Converted.String())
+			assert.Equal(t, pb.Side_SIDE_UNSPECIFIED, txs[2].Side)
+			assert.Equal(t, pb.Side_SIDE_BUY, txs[3].Side)
+			// Lines count the CSV header and blank JSONL lines
+			assert.Equal(t, 5, txs[3].line)
+		})
+	}
+}
+
+func TestReadTransactionsRejectsMalformedExports(t *testing.T) {
+	for _, tc := range []struct {
+		format, export, err string
+	}{
+		{"csv", "id,timestamp,source_currency,target_currency,amount\n1,2024-03-15T10:00:00Z,USD,INR,100\n", "line 2: missing converted_amount"},
+		{"csv", "id,timestamp,source_currency,target_currency,amount,converted_amount\n1,15/03/2024,USD,INR,100,8312\n", "line 2: timestamp must be RFC 3339"},
+		{"csv", "id,timestamp,source_currency,target_currency,amount,converted_amount,side\n1,2024-03-15T10:00:00Z,USD,INR,100,8312,hold\n", "line 2: side must be buy or sell"},
+		{"jsonl", `{"id": "1", "timestamp": "2024-03-15T10:00:00Z", "source_currency": "USD", "target_currency": "INR", "amount": "1e", "converted_amount": 8312}`, "line 1: invalid amount"},
+		{"jsonl", `{"id": 1, "timestamp": "2024-03-15T10:00:00Z", "source_currency": ["USD"]}`, "line 1: source_currency must be a string or a number"},
+		{"jsonl", "not json", "line 1: invalid character"},
+		{"xml", "", "unknown export format"},
+	} {
+		_, err := readTransactions(strings.NewReader(tc.export), tc.format)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), tc.err)
+		}
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	s := newTestServer()
+	s.store.(*memoryStore).SetAt("USD", decimal.NewFromInt(80), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	txs, err := readTransactions(strings.NewReader(testExportCSV), "csv")
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	rec := s.reconcile(ctx, txs, decimal.RequireFromString("0.01"))
+	assert.False(t, rec.ok())
+	assert.Equal(t, 5, rec.transactions)
+	if assert.Len(t, rec.mismatches, 1) {
+		assert.Equal(t, "b", rec.mismatches[0].ID)
+		assert.Equal(t, "8000", rec.mismatches[0].expected.String())
+		assert.Equal(t, "312", rec.mismatches[0].difference().String())
+	}
+	if assert.Len(t, rec.failed, 1) {
+		assert.Equal(t, "e", rec.failed[0].ID)
+	}
+
+	inr := rec.totals["INR"]
+	assert.Equal(t, 3, inr.transactions)
+	assert.Equal(t, 1, inr.mismatches)
+	assert.Equal(t, "24624.01", inr.converted.String())
+	assert.Equal(t, "24312", inr.expected.String())
+	assert.Equal(t, 1, rec.totals["USD"].transactions)
+	assert.Equal(t, 1, rec.totals["XYZ"].failed)
+	assert.True(t, rec.totals["XYZ"].converted.IsZero())
+
+	var out bytes.Buffer
+	assert.NoError(t, rec.write(&out))
+	report := out.String()
+	assert.Contains(t, report, "MISMATCHES")
+	assert.Regexp(t, `3 +b +2024-03-15T10:00:00Z +USD/INR +100 +8312 +8000 +312`, report)
+	assert.Regexp(t, `6 +e +2024-03-15T10:00:00Z +USD/XYZ +unknown currency XYZ`, report)
+	assert.Regexp(t, `INR +3 +1 +0 +24624.01 +24312 +312.01`, report)
+	assert.Contains(t, report, "5 transactions, 1 mismatches beyond 0.01, 1 failed")
+}
+
+func TestReconcileAppliesFees(t *testing.T) {
+	s := newTestServer()
+	s.fees = testFees()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	at := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	rec := s.reconcile(ctx, []walletTransaction{
+		// The acme fee is a fixed 25 INR, the usd-inr fee 0.25% of 8312
+		{ID: "acme", Timestamp: at, ClientID: "acme", Source: "USD", Target: "INR", Amount: decimal.NewFromInt(100), Converted: decimal.NewFromInt(8287)},
+		{ID: "other", Timestamp: at, ClientID: "other", Source: "USD", Target: "INR", Amount: decimal.NewFromInt(100), Converted: decimal.RequireFromString("8291.22")},
+	}, decimal.Zero)
+	assert.True(t, rec.ok())
+
+	var out bytes.Buffer
+	assert.NoError(t, rec.write(&out))
+	assert.NotContains(t, out.String(), "MISMATCHES\n")
+	assert.Contains(t, out.String(), "2 transactions, 0 mismatches beyond 0, 0 failed")
+}
+
+func TestReconcileForwardsConfigFlags(t *testing.T) {
+	fs := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	cfgArgs := forwardConfigFlags(fs)
+	tolerance := fs.String("tolerance", "0.01", "")
+	path := writeFile(t, "config.yaml", "base_currency: USD\n")
+
+	err := fs.Parse([]string{"-config", path, "-db-dsn", "postgres://reconcile", "-allow-negative-amounts=true", "-tolerance", "1", "export.csv"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", *tolerance)
+	assert.Equal(t, []string{"export.csv"}, fs.Args())
+
+	cfg, err := loadConfig(*cfgArgs, envFrom(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", cfg.BaseCurrency)
+	assert.Equal(t, "postgres://reconcile", cfg.Database.DSN)
+	assert.True(t, cfg.amountPolicy().AllowNegative)
+}
+
+func TestReconcileAppliesAmountPolicy(t *testing.T) {
+	s := newTestServer()
+	s.policy.AllowNegative = false
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	refund := walletTransaction{ID: "refund", Timestamp: time.Now(), Source: "USD", Target: "INR", Amount: decimal.NewFromInt(-100), Converted: decimal.NewFromInt(-8312)}
+
+	rec := s.reconcile(ctx, []walletTransaction{refund}, decimal.Zero)
+	if assert.Len(t, rec.failed, 1) {
+		assert.EqualError(t, rec.failed[0].err, "amount must not be negative")
+	}
+
+	s.policy.AllowNegative = true
+	assert.True(t, s.reconcile(ctx, []walletTransaction{refund}, decimal.Zero).ok())
+}
+
+func TestReadTransactionsOptionalFields(t *testing.T) {
+	export := "id,timestamp,source_currency,target_currency,amount,converted_amount,rounding_mode,conversion_id\n" +
+		"1,2024-03-15T10:00:00Z,EUR,USD,100,108.81,down,c1\n" +
+		"2,2024-03-15T10:00:00Z,EUR,USD,100,108.82,ROUNDING_MODE_HALF_EVEN,\n" +
+		"3,2024-03-15T10:00:00Z,EUR,USD,100,108.82,,\n"
+	txs, err := readTransactions(strings.NewReader(export), "csv")
+	assert.NoError(t, err)
+	if assert.Len(t, txs, 3) {
+		assert.Equal(t, pb.RoundingMode_ROUNDING_MODE_DOWN, txs[0].RoundingMode)
+		assert.Equal(t, "c1", txs[0].ConversionID)
+		assert.Equal(t, pb.RoundingMode_ROUNDING_MODE_HALF_EVEN, txs[1].RoundingMode)
+		assert.Equal(t, pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED, txs[2].RoundingMode)
+		assert.Empty(t, txs[2].ConversionID)
+	}
+
+	for _, mode := range []string{"nearest", "unspecified"} {
+		_, err = readTransactions(strings.NewReader("id,timestamp,source_currency,target_currency,amount,converted_amount,rounding_mode\n"+
+			"1,2024-03-15T10:00:00Z,EUR,USD,100,108.81,"+mode+"\n"), "csv")
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "line 2: rounding_mode must be")
+		}
+	}
+}
+
+func TestReconcileUsesRoundingMode(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// 100 EUR is 108.8185... USD
+	tx := walletTransaction{ID: "1", Timestamp: time.Now(), Source: "EUR", Target: "USD", Amount: decimal.NewFromInt(100), Converted: decimal.RequireFromString("108.81")}
+
+	rec := s.reconcile(ctx, []walletTransaction{tx}, decimal.Zero)
+	if assert.Len(t, rec.mismatches, 1) {
+		assert.Equal(t, "108.82", rec.mismatches[0].expected.String())
+	}
+
+	tx.RoundingMode = pb.RoundingMode_ROUNDING_MODE_DOWN
+	assert.True(t, s.reconcile(ctx, []walletTransaction{tx}, decimal.Zero).ok())
+}
+
+func TestReconcileComparesRecordedConversions(t *testing.T) {
+	s := newTestServer()
+	s.fees = testFees()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+
+	// Fees are no longer charged, and no rate was in force at the exported timestamp, but the
+	// recorded conversion is what the wallet is checked against
+	s.fees = nil
+	at := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	recorded := walletTransaction{ID: "recorded", Timestamp: at, Source: "USD", Target: "INR", Amount: decimal.NewFromInt(100),
+		Converted: decimal.RequireFromString("8291.22"), ConversionID: res.ConversionId}
+	wrongAmount := recorded
+	wrongAmount.ID, wrongAmount.Amount = "wrong-amount", decimal.NewFromInt(200)
+	unknown := recorded
+	unknown.ID, unknown.ConversionID = "unknown", "missing"
+
+	rec := s.reconcile(ctx, []walletTransaction{recorded, wrongAmount, unknown}, decimal.Zero)
+	assert.Empty(t, rec.mismatches)
+	if assert.Len(t, rec.failed, 2) {
+		assert.EqualError(t, rec.failed[0].err, "conversion "+res.ConversionId+" was of 100 USD to INR")
+		assert.ErrorIs(t, rec.failed[1].err, ErrConversionNotFound)
+	}
+	assert.Equal(t, "8291.22", rec.totals["INR"].expected.String())
+}
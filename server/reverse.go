@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+// ConvertToTarget implements the gRPC method finding the source amount needed to receive a target amount
+func (s *server) ConvertToTarget(ctx context.Context, req *pb.ConvertToTargetRequest) (*pb.ConvertToTargetResponse, error) {
+	params, err := s.policy.validateConvertToTargetRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	target := params.amount
+	params.client = callerIdentity(ctx)
+
+	rt, err := s.resolveRoute(ctx, params.source.Code, params.target.Code, params.asOf)
+	if err != nil {
+		return nil, err
+	}
+	rt = rt.withSide(params.side)
+	exact := rt.reverse().apply(target)
+	source := sourceForTarget(rt, target, exact, params, s.fees)
+
+	// Convert the source amount forward along the same route so the result is exactly what Convert would return
+	forward := params
+	forward.amount = source
+	forward.amountField = "source_amount"
+	conversion, err := s.convertResponse(forward, conversion{amount: rt.apply(source), route: rt})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.policy.checkAmount(source); err != nil {
+		return nil, invalidArgumentError(fieldViolation(params.amountField, err))
+	}
+	sourceMoney, err := moneyFromDecimal(source, params.source.Code)
+	if err != nil {
+		return nil, status.Errorf(codes.OutOfRange, "source amount out of range: %v", err)
+	}
+
+	return &pb.ConvertToTargetResponse{
+		SourceAmount:          source.InexactFloat64(),
+		SourceMoney:           sourceMoney,
+		UnroundedSourceAmount: exact.String(),
+		Conversion:            conversion,
+	}, nil
+}
+
+// sourceForTarget returns the smallest source amount, in whole source minor units, whose forward
+// conversion after fees rounds to at least target: the amount received net of fees, or on SIDE_SELL
+// the amount paid including them. Either grows with the source amount, so it is found by bisection
+// between zero, which is never charged and never reaches the positive target, and the exact amount
+// rounded up, doubled until it also covers any deducted fee.
+func sourceForTarget(rt route, target, exact decimal.Decimal, params convertParams, fees feeSchedule) decimal.Decimal {
+	unit := decimal.New(1, -params.source.MinorUnits)
+	reaches := func(units decimal.Decimal) bool {
+		return fees.apply(params, rt.apply(units.Mul(unit))).net.GreaterThanOrEqual(target)
+	}
+
+	// The exact amount rounded up converts to at least target before rounding; step up in
+	// case the forward rounding mode rounds it down or a fee is charged
+	hi := exact.Div(unit).Ceil()
+	if !reaches(hi) {
+		hi = hi.Add(decimal.NewFromInt(1))
+	}
+	for !reaches(hi) {
+		hi = hi.Mul(decimal.NewFromInt(2))
+	}
+	lo := decimal.Zero
+	two := decimal.NewFromInt(2)
+	for hi.Sub(lo).GreaterThan(decimal.NewFromInt(1)) {
+		mid := lo.Add(hi).Div(two).Floor()
+		if reaches(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi.Mul(unit)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestConvertToTarget(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.ConvertToTarget(ctx, &pb.ConvertToTargetRequest{TargetAmount: 10000, SourceCurrency: "USD", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, "120.307988450433108758", res.UnroundedSourceAmount)
+	assert.Equal(t, 120.31, res.SourceAmount)
+	assert.Equal(t, &pb.Money{CurrencyCode: "USD", Units: 120, Nanos: 310000000}, res.SourceMoney)
+	// 120.30 USD would only yield 9999.34 INR
+	assert.Equal(t, "10000.1672", res.Conversion.UnroundedAmount)
+	assert.Equal(t, 10000.17, res.Conversion.ConvertedAmount)
+}
+
+func TestConvertToTargetIsMinimalAndSufficient(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	pairs := [][2]string{{"USD", "INR"}, {"INR", "USD"}, {"JPY", "KWD"}, {"KWD", "JPY"}, {"EUR", "JPY"}, {"USD", "USD"}}
+	targets := []string{"0.01", "1", "10000", "123.45", "99999.99"}
+	modes := []pb.RoundingMode{pb.RoundingMode_ROUNDING_MODE_HALF_UP, pb.RoundingMode_ROUNDING_MODE_DOWN, pb.RoundingMode_ROUNDING_MODE_HALF_EVEN, pb.RoundingMode_ROUNDING_MODE_UP}
+	for _, pair := range pairs {
+		source, _ := lookupCurrency(pair[0])
+		target, _ := lookupCurrency(pair[1])
+		for _, amount := range targets {
+			want := decimal.RequireFromString(amount).Truncate(target.MinorUnits)
+			if !want.IsPositive() {
+				continue
+			}
+			money, _ := moneyFromDecimal(want, target.Code)
+			for _, mode := range modes {
+				res, err := s.ConvertToTarget(ctx, &pb.ConvertToTargetRequest{
+					TargetMoney:    money,
+					SourceCurrency: source.Code,
+					TargetCurrency: target.Code,
+					RoundingMode:   mode,
+				})
+				if !assert.NoError(t, err) {
+					continue
+				}
+				got, _ := decimalFromMoney(res.Conversion.ConvertedMoney)
+				assert.True(t, got.GreaterThanOrEqual(want), "%s %s->%s %s: got %s", amount, source.Code, target.Code, mode, got)
+
+				// One minor unit less of the source currency falls short
+				sourceAmount, _ := decimalFromMoney(res.SourceMoney)
+				lessMoney, _ := moneyFromDecimal(sourceAmount.Sub(decimal.New(1, -source.MinorUnits)), source.Code)
+				less, err := s.Convert(ctx, &pb.ConvertRequest{
+					AmountMoney:    lessMoney,
+					SourceCurrency: source.Code,
+					TargetCurrency: target.Code,
+					RoundingMode:   mode,
+				})
+				if assert.NoError(t, err) {
+					short, _ := decimalFromMoney(less.ConvertedMoney)
+					assert.True(t, short.LessThan(want), "%s %s->%s %s: %s is not minimal", amount, source.Code, target.Code, mode, sourceAmount)
+				}
+			}
+		}
+	}
+}
+
+func TestConvertToTargetInvalidRequest(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for _, req := range []*pb.ConvertToTargetRequest{
+		{TargetAmount: -5, SourceCurrency: "USD", TargetCurrency: "INR"},
+		{TargetAmount: 0, SourceCurrency: "USD", TargetCurrency: "INR"},
+		{TargetAmount: 10.005, SourceCurrency: "USD", TargetCurrency: "INR"},
+		{TargetAmount: 10.5, SourceCurrency: "USD", TargetCurrency: "JPY"},
+		{TargetMoney: &pb.Money{CurrencyCode: "USD", Units: 10}, SourceCurrency: "USD", TargetCurrency: "INR"},
+	} {
+		_, err := s.ConvertToTarget(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
+
+func TestConvertToTargetOnSide(t *testing.T) {
+	s := newSpreadTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Bought at the 83.00 bid rather than the 83.12 mid
+	res, err := s.ConvertToTarget(ctx, &pb.ConvertToTargetRequest{TargetAmount: 10000, SourceCurrency: "USD", TargetCurrency: "INR", Side: pb.Side_SIDE_BUY})
+	assert.NoError(t, err)
+	assert.Equal(t, 120.49, res.SourceAmount)
+	assert.Equal(t, "10000.67", res.Conversion.UnroundedAmount)
+	assert.Equal(t, pb.Side_SIDE_BUY, res.Conversion.Side)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	pb "CurrencyConverter/proto"
+)
+
+// defaultRoundingMode is applied when a request leaves the rounding mode unspecified
+const defaultRoundingMode = pb.RoundingMode_ROUNDING_MODE_HALF_UP
+
+// resolveRoundingMode replaces an unspecified mode with the default and rejects unknown values
+func resolveRoundingMode(mode pb.RoundingMode) (pb.RoundingMode, error) {
+	if mode == pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED {
+		return defaultRoundingMode, nil
+	}
+	if _, ok := pb.RoundingMode_name[int32(mode)]; !ok {
+		return mode, fmt.Errorf("unknown rounding mode %d", mode)
+	}
+	return mode, nil
+}
+
+// roundAmount rounds d to the given number of decimal places using a resolved rounding mode
+func roundAmount(d decimal.Decimal, places int32, mode pb.RoundingMode) decimal.Decimal {
+	switch mode {
+	case pb.RoundingMode_ROUNDING_MODE_HALF_EVEN:
+		return d.RoundBank(places)
+	case pb.RoundingMode_ROUNDING_MODE_DOWN:
+		return d.RoundDown(places)
+	case pb.RoundingMode_ROUNDING_MODE_UP:
+		return d.RoundUp(places)
+	case pb.RoundingMode_ROUNDING_MODE_CEILING:
+		return d.RoundCeil(places)
+	case pb.RoundingMode_ROUNDING_MODE_FLOOR:
+		return d.RoundFloor(places)
+	default:
+		return d.Round(places)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestRoundAmountModes(t *testing.T) {
+	tests := []struct {
+		mode     pb.RoundingMode
+		value    string
+		expected string
+	}{
+		{pb.RoundingMode_ROUNDING_MODE_HALF_EVEN, "2.345", "2.34"},
+		{pb.RoundingMode_ROUNDING_MODE_HALF_EVEN, "2.355", "2.36"},
+		{pb.RoundingMode_ROUNDING_MODE_HALF_UP, "2.345", "2.35"},
+		{pb.RoundingMode_ROUNDING_MODE_HALF_UP, "-2.345", "-2.35"},
+		{pb.RoundingMode_ROUNDING_MODE_DOWN, "2.349", "2.34"},
+		{pb.RoundingMode_ROUNDING_MODE_DOWN, "-2.349", "-2.34"},
+		{pb.RoundingMode_ROUNDING_MODE_UP, "2.341", "2.35"},
+		{pb.RoundingMode_ROUNDING_MODE_UP, "-2.341", "-2.35"},
+		{pb.RoundingMode_ROUNDING_MODE_CEILING, "-2.349", "-2.34"},
+		{pb.RoundingMode_ROUNDING_MODE_FLOOR, "-2.341", "-2.35"},
+	}
+	for _, tt := range tests {
+		got := roundAmount(decimal.RequireFromString(tt.value), 2, tt.mode)
+		assert.Equal(t, tt.expected, got.StringFixed(2), "%s %s", tt.mode, tt.value)
+	}
+}
+
+func TestResolveRoundingMode(t *testing.T) {
+	mode, err := resolveRoundingMode(pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.RoundingMode_ROUNDING_MODE_HALF_UP, mode)
+
+	mode, err = resolveRoundingMode(pb.RoundingMode_ROUNDING_MODE_FLOOR)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.RoundingMode_ROUNDING_MODE_FLOOR, mode)
+
+	_, err = resolveRoundingMode(pb.RoundingMode(42))
+	assert.Error(t, err)
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// hop applies one stored rate, multiplying by it or, when the rate is quoted
+// the other way round, dividing by it
+type hop struct {
+	rate    Rate
+	inverse bool
+}
+
+// value returns the rate the hop applies on a side. Without a side it is the mid rate. Buying the
+// target, every hop hands over the currency it converts from, which is bought at the bid, or takes
+// the currency it converts to, which is sold at the ask; selling the target reverses both.
+func (h hop) value(side pb.Side) decimal.Decimal {
+	switch {
+	case side == pb.Side_SIDE_UNSPECIFIED:
+		return h.rate.Value
+	case h.inverse == (side == pb.Side_SIDE_BUY):
+		return h.rate.ask()
+	default:
+		return h.rate.bid()
+	}
+}
+
+// route is the sequence of rates that takes an amount from the source to the target currency
+type route struct {
+	kind   pb.ConversionRoute
+	source string
+	hops   []hop
+	// side selects the bid or ask of each rate; unspecified uses mid rates
+	side pb.Side
+}
+
+// withSide returns the route priced on the given side
+func (r route) withSide(side pb.Side) route {
+	r.side = side
+	return r
+}
+
+// apply converts amount along the route, dividing only once at the end to keep full precision
+func (r route) apply(amount decimal.Decimal) decimal.Decimal {
+	return r.applyTrace(amount, nil)
+}
+
+// applyTrace is apply, also appending each arithmetic step to trace when it is not nil
+func (r route) applyTrace(amount decimal.Decimal, trace *[]*pb.CalculationStep) decimal.Decimal {
+	step := func(description string, operand, result decimal.Decimal) {
+		if trace != nil {
+			*trace = append(*trace, &pb.CalculationStep{Description: description, Operand: operand.String(), Result: result.String()})
+		}
+	}
+
+	numerator, denominator := amount, decimal.NewFromInt(1)
+	step("amount in "+r.source, amount, numerator)
+	for _, h := range r.hops {
+		name := h.rate.Base + "/" + h.rate.Quote + " " + r.priceName(h) + "rate"
+		value := h.value(r.side)
+		if h.inverse {
+			denominator = denominator.Mul(value)
+			step("multiply divisor by "+name, value, denominator)
+		} else {
+			numerator = numerator.Mul(value)
+			step("multiply amount by "+name, value, numerator)
+		}
+	}
+	converted := numerator.DivRound(denominator, divisionPrecision)
+	if len(r.hops) > 0 {
+		step(fmt.Sprintf("divide amount by divisor, rounded to %d decimal places", divisionPrecision), denominator, converted)
+	}
+	return converted
+}
+
+// priceName names the price of a hop's rate used on the route's side, followed by a space
+func (r route) priceName(h hop) string {
+	switch {
+	case r.side == pb.Side_SIDE_UNSPECIFIED:
+		return ""
+	case h.inverse == (r.side == pb.Side_SIDE_BUY):
+		return "ask "
+	default:
+		return "bid "
+	}
+}
+
+// rate returns the effective rate of the route: one unit of source in target
+func (r route) rate() decimal.Decimal {
+	return r.apply(decimal.NewFromInt(1))
+}
+
+// inverseRate returns one unit of target in source, computed from the exact rates rather than from rate()
+func (r route) inverseRate() decimal.Decimal {
+	return r.reverse().rate()
+}
+
+// reverse returns the route from the target back to the source, applying the same rate values:
+// a hop turned around takes the other price on the opposite side
+func (r route) reverse() route {
+	reversed := route{kind: r.kind, source: r.path()[len(r.hops)], hops: make([]hop, len(r.hops)), side: oppositeSide(r.side)}
+	for i, h := range r.hops {
+		reversed.hops[len(r.hops)-1-i] = hop{rate: h.rate, inverse: !h.inverse}
+	}
+	return reversed
+}
+
+// oppositeSide swaps buying and selling, leaving an unspecified side as it is
+func oppositeSide(side pb.Side) pb.Side {
+	switch side {
+	case pb.Side_SIDE_BUY:
+		return pb.Side_SIDE_SELL
+	case pb.Side_SIDE_SELL:
+		return pb.Side_SIDE_BUY
+	}
+	return side
+}
+
+// updatedAt returns when the most recently changed rate on the route took effect
+func (r route) updatedAt() time.Time {
+	var updated time.Time
+	for _, h := range r.hops {
+		if h.rate.EffectiveFrom.After(updated) {
+			updated = h.rate.EffectiveFrom
+		}
+	}
+	return updated
+}
+
+// providers lists the distinct providers of the route's rates in order of use
+func (r route) providers() []string {
+	var providers []string
+	seen := make(map[string]bool)
+	for _, h := range r.hops {
+		if h.rate.Provider != "" && !seen[h.rate.Provider] {
+			seen[h.rate.Provider] = true
+			providers = append(providers, h.rate.Provider)
+		}
+	}
+	return providers
+}
+
+// path lists the currencies the route passes through, starting with the source
+func (r route) path() []string {
+	path := []string{r.source}
+	for _, h := range r.hops {
+		path = append(path, h.to())
+	}
+	return path
+}
+
+// appliedRates describes the route's rates for a response
+func (r route) appliedRates() []*pb.AppliedRate {
+	applied := make([]*pb.AppliedRate, len(r.hops))
+	for i, h := range r.hops {
+		applied[i] = &pb.AppliedRate{
+			BaseCurrency:  h.rate.Base,
+			QuoteCurrency: h.rate.Quote,
+			Rate:          h.value(r.side).String(),
+			EffectiveFrom: timestamppb.New(h.rate.EffectiveFrom),
+			Inverted:      h.inverse,
+			Provider:      h.rate.Provider,
+		}
+		if !h.rate.Bid.IsZero() {
+			applied[i].Bid = h.rate.Bid.String()
+		}
+		if !h.rate.Ask.IsZero() {
+			applied[i].Ask = h.rate.Ask.String()
+		}
+	}
+	return applied
+}
+
+// resolveRoute prefers a directly quoted pair rate and falls back to pivoting through the base currency
+func (s *server) resolveRoute(ctx context.Context, sourceCurrency, targetCurrency string, asOf time.Time) (route, error) {
+	if sourceCurrency == targetCurrency {
+		return route{kind: pb.ConversionRoute_CONVERSION_ROUTE_IDENTITY, source: sourceCurrency}, nil
+	}
+
+	pair, err := s.store.Pair(ctx, sourceCurrency, targetCurrency, asOf)
+	if err == nil {
+		return checkRoute(route{
+			kind:   pb.ConversionRoute_CONVERSION_ROUTE_DIRECT,
+			source: sourceCurrency,
+			hops:   []hop{{rate: pair, inverse: pair.Base != sourceCurrency}},
+		})
+	}
+	if !errors.Is(err, ErrRateNotFound) {
+		log.Printf("Error retrieving pair rate for %s/%s: %v", sourceCurrency, targetCurrency, err)
+		return route{}, rateLookupError(sourceCurrency+"/"+targetCurrency, err)
+	}
+
+	// The base currency needs no rate of its own
+	rt := route{kind: pb.ConversionRoute_CONVERSION_ROUTE_PIVOT, source: sourceCurrency}
+	base := s.store.Base()
+
+	// Retrieve source rate
+	if sourceCurrency != base {
+		sourceRate, err := s.store.Rate(ctx, sourceCurrency, asOf)
+		if err != nil {
+			log.Printf("Error retrieving source rate for %s: %v", sourceCurrency, err)
+			return s.crossRoute(ctx, sourceCurrency, targetCurrency, asOf, sourceCurrency, err)
+		}
+		rt.hops = append(rt.hops, hop{rate: sourceRate})
+	}
+
+	// Retrieve target rate
+	if targetCurrency != base {
+		targetRate, err := s.store.Rate(ctx, targetCurrency, asOf)
+		if err != nil {
+			log.Printf("Error retrieving target rate for %s: %v", targetCurrency, err)
+			return s.crossRoute(ctx, sourceCurrency, targetCurrency, asOf, targetCurrency, err)
+		}
+		rt.hops = append(rt.hops, hop{rate: targetRate, inverse: true})
+	}
+
+	return checkRoute(rt)
+}
+
+// crossRoute searches the graph of all known rates when the pivot route is missing a rate.
+// If no path exists the original lookup failure for the missing currency is reported.
+func (s *server) crossRoute(ctx context.Context, sourceCurrency, targetCurrency string, asOf time.Time, missing string, cause error) (route, error) {
+	if !errors.Is(cause, ErrRateNotFound) {
+		return route{}, rateLookupError(missing, cause)
+	}
+
+	graph, err := storeRateGraph(ctx, s.store, asOf)
+	if err != nil {
+		log.Printf("Error retrieving all rates: %v", err)
+		return route{}, rateLookupError(missing, err)
+	}
+	hops, ok := graph.findPath(sourceCurrency, targetCurrency, s.paths)
+	if !ok {
+		return route{}, rateLookupError(missing, cause)
+	}
+	return checkRoute(route{kind: pb.ConversionRoute_CONVERSION_ROUTE_CROSS, source: sourceCurrency, hops: hops})
+}
+
+// storeRateGraph returns the graph of every rate in effect at asOf, reusing the store's own graph
+// when it keeps one for a snapshot of its rates
+func storeRateGraph(ctx context.Context, store RateStore, asOf time.Time) (*rateGraph, error) {
+	if gs, ok := store.(graphStore); ok {
+		return gs.RateGraph(ctx, asOf)
+	}
+	rates, err := store.AllRates(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return newRateGraph(rates), nil
+}
+
+// checkRoute rejects routes containing a non-positive rate, which would make the conversion meaningless
+func checkRoute(r route) (route, error) {
+	for _, h := range r.hops {
+		if !h.rate.Value.IsPositive() || h.rate.Bid.IsNegative() || h.rate.Ask.IsNegative() {
+			return route{}, status.Errorf(codes.Internal, "conversion rate for %s/%s is not positive", h.rate.Base, h.rate.Quote)
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+func TestRouteApplyDividesOnce(t *testing.T) {
+	rt := route{hops: []hop{
+		{rate: Rate{Value: decimal.NewFromInt(1)}},
+		{rate: Rate{Value: decimal.NewFromInt(3)}, inverse: true},
+		{rate: Rate{Value: decimal.NewFromInt(3)}},
+	}}
+	// Dividing by 3 before multiplying by 3 would leave 0.999...
+	assert.Equal(t, "1", rt.apply(decimal.NewFromInt(1)).String())
+}
+
+func TestResolveRoutePrefersDirectPair(t *testing.T) {
+	s := newTestServer()
+	s.store.(*memoryStore).SetPair("EUR", "USD", decimal.RequireFromString("1.0850"))
+
+	rt, err := s.resolveRoute(context.Background(), "EUR", "USD", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_DIRECT, rt.kind)
+	assert.Equal(t, "108.5", rt.apply(decimal.NewFromInt(100)).String())
+
+	// The same pair quoted the other way round is divided by
+	rt, err = s.resolveRoute(context.Background(), "USD", "EUR", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_DIRECT, rt.kind)
+	assert.True(t, rt.hops[0].inverse)
+	assert.Equal(t, "100", rt.apply(decimal.RequireFromString("108.5")).String())
+}
+
+func TestResolveRouteFallsBackToPivot(t *testing.T) {
+	s := newTestServer()
+
+	rt, err := s.resolveRoute(context.Background(), "EUR", "USD", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_PIVOT, rt.kind)
+	if assert.Len(t, rt.hops, 2) {
+		assert.Equal(t, "EUR", rt.hops[0].rate.Base)
+		assert.Equal(t, "USD", rt.hops[1].rate.Base)
+		assert.True(t, rt.hops[1].inverse)
+	}
+}
+
+func TestResolveRouteRejectsZeroRate(t *testing.T) {
+	s := newTestServer()
+	s.store.(*memoryStore).Set("EUR", decimal.Zero)
+
+	_, err := s.resolveRoute(context.Background(), "USD", "EUR", time.Time{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestHopValueBySide(t *testing.T) {
+	r := Rate{Value: decimal.RequireFromString("83.12"), Bid: decimal.RequireFromString("83"), Ask: decimal.RequireFromString("83.24")}
+	forward, backward := hop{rate: r}, hop{rate: r, inverse: true}
+
+	assert.Equal(t, "83.12", forward.value(pb.Side_SIDE_UNSPECIFIED).String())
+	// Buying the target, the base currency handed over is bought at the bid and the base currency taken is sold at the ask
+	assert.Equal(t, "83", forward.value(pb.Side_SIDE_BUY).String())
+	assert.Equal(t, "83.24", backward.value(pb.Side_SIDE_BUY).String())
+	assert.Equal(t, "83.24", forward.value(pb.Side_SIDE_SELL).String())
+	assert.Equal(t, "83", backward.value(pb.Side_SIDE_SELL).String())
+
+	// Without a published bid and ask every side uses the mid rate
+	mid := hop{rate: Rate{Value: decimal.RequireFromString("0.5571")}}
+	assert.Equal(t, "0.5571", mid.value(pb.Side_SIDE_BUY).String())
+	assert.Equal(t, "0.5571", mid.value(pb.Side_SIDE_SELL).String())
+}
+
+func TestRouteReverseKeepsSidePrices(t *testing.T) {
+	rt := route{source: "EUR", side: pb.Side_SIDE_BUY, hops: []hop{
+		{rate: Rate{Base: "EUR", Quote: "INR", Value: decimal.RequireFromString("90.45"), Bid: decimal.RequireFromString("90.30"), Ask: decimal.RequireFromString("90.60")}},
+		{rate: Rate{Base: "USD", Quote: "INR", Value: decimal.RequireFromString("83.12"), Bid: decimal.RequireFromString("83"), Ask: decimal.RequireFromString("83.24")}, inverse: true},
+	}}
+	reversed := rt.reverse()
+	assert.Equal(t, pb.Side_SIDE_SELL, reversed.side)
+	for i, h := range rt.hops {
+		assert.Equal(t, h.value(rt.side), reversed.hops[len(rt.hops)-1-i].value(reversed.side))
+	}
+	assert.Equal(t, "108.481499279192695819", rt.apply(decimal.NewFromInt(100)).String())
+	assert.Equal(t, "100", reversed.apply(rt.apply(decimal.NewFromInt(100))).Round(12).String())
+}
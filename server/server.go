@@ -6,91 +6,327 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	_ "github.com/lib/pq"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "CurrencyConverter/proto"
 )
 
 type server struct {
 	pb.UnimplementedCurrencyConverterServer
-	db *sql.DB
+	store  RateStore
+	policy amountPolicy
+	paths  pathOptions
+	hub    *rateHub
+	fees   feeSchedule
+
+	quotes   QuoteStore
+	quoteTTL time.Duration
+
+	idempotency       IdempotencyStore
+	idempotencyWindow time.Duration
+
+	ledger Ledger
+}
+
+func newServer(store RateStore) *server {
+	// Quotes and idempotency keys record conversions in the same ledger as Convert
+	ledger := newMemoryLedger()
+	return &server{
+		store:  store,
+		policy: defaultAmountPolicy(),
+		paths:  defaultPathOptions(),
+		hub:    newRateHub(store, notifierOf(store), defaultStreamOptions()),
+
+		quotes:   newMemoryQuoteStore(ledger),
+		quoteTTL: defaultQuoteTTL,
+
+		idempotency:       newMemoryIdempotencyStore(ledger),
+		idempotencyWindow: defaultIdempotencyWindow,
+
+		ledger: ledger,
+	}
+}
+
+// withStore returns a copy of the server that reads rates from store
+func (s *server) withStore(store RateStore) *server {
+	c := *s
+	c.store = store
+	return &c
 }
 
 // Initializes a connection to PostgreSQL
-func initDB() (*sql.DB, error) {
-	connStr := "user=postgres password=1234 dbname=currencydb sslmode=disable" // Updated username
+func initDB(cfg DatabaseConfig) (*sql.DB, error) {
+	connStr, err := cfg.connString()
+	if err != nil {
+		return nil, err
+	}
 	db, err := sql.Open("postgres", connStr)
 	if err != nil {
 		return nil, err
 	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
 	// Verify connection
-	if err := db.Ping(); err != nil {
+	ctx := context.Background()
+	if cfg.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.ConnectTimeout)
+		defer cancel()
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
 		return nil, err
 	}
 	return db, nil
 }
 
-// convertCurrency retrieves conversion rates from the database
-func (s *server) convertCurrency(ctx context.Context, amount float64, sourceCurrency, targetCurrency string) (float64, error) {
-	var sourceRate, targetRate float64
-
-	// Retrieve source rate
-	err := s.db.QueryRowContext(ctx, "SELECT rate FROM conversion_rates WHERE currency = $1", sourceCurrency).Scan(&sourceRate)
-	if err != nil {
-		log.Printf("Error retrieving source rate for %s: %v", sourceCurrency, err)
-		return 0, fmt.Errorf("conversion rate not found for %s", sourceCurrency)
+// timeoutInterceptor bounds every unary RPC by the configured request timeout
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
 	}
+}
 
-	// Retrieve target rate
-	err = s.db.QueryRowContext(ctx, "SELECT rate FROM conversion_rates WHERE currency = $1", targetCurrency).Scan(&targetRate)
+// conversion is the unrounded result of convertCurrency and the route it took
+type conversion struct {
+	amount decimal.Decimal
+	route  route
+}
+
+// convertCurrency converts an amount using the rates in effect at asOf, priced on the given side
+func (s *server) convertCurrency(ctx context.Context, amount decimal.Decimal, sourceCurrency, targetCurrency string, asOf time.Time, side pb.Side) (conversion, error) {
+	rt, err := s.resolveRoute(ctx, sourceCurrency, targetCurrency, asOf)
 	if err != nil {
-		log.Printf("Error retrieving target rate for %s: %v", targetCurrency, err)
-		return 0, fmt.Errorf("conversion rate not found for %s", targetCurrency)
+		return conversion{}, err
 	}
+	rt = rt.withSide(side)
 
 	// Convert the amount
-	inrAmount := amount * sourceRate
-	return inrAmount / targetRate, nil
+	return conversion{amount: rt.apply(amount), route: rt}, nil
 }
 
 // Convert implements the gRPC method for currency conversion
 func (s *server) Convert(ctx context.Context, req *pb.ConvertRequest) (*pb.ConvertResponse, error) {
-	amount := req.GetAmount()
-	sourceCurrency := req.GetSourceCurrency()
-	targetCurrency := req.GetTargetCurrency()
+	params, err := s.policy.validateConvertRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	key, err := requestIdempotencyKey(ctx, req.GetIdempotencyKey())
+	if err != nil {
+		return nil, invalidArgumentError(fieldViolation("idempotency_key", err))
+	}
+	if key != "" {
+		return s.convertOnce(ctx, key, req, params)
+	}
+	res, rt, err := s.convert(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordConversion(ctx, req, params, res, rt); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
+// convert performs a validated conversion, also returning the route it took
+func (s *server) convert(ctx context.Context, params convertParams) (*pb.ConvertResponse, route, error) {
+	params.client = callerIdentity(ctx)
 	// Call the conversion function
-	convertedAmount, err := s.convertCurrency(ctx, amount, sourceCurrency, targetCurrency)
+	converted, err := s.convertCurrency(ctx, params.amount, params.source.Code, params.target.Code, params.asOf, params.side)
 	if err != nil {
+		return nil, route{}, err
+	}
+	res, err := s.convertResponse(params, converted)
+	return res, converted.route, err
+}
+
+// convertResponse checks, rounds and charges fees on a conversion and describes it for a response
+func (s *server) convertResponse(params convertParams, converted conversion) (*pb.ConvertResponse, error) {
+	convertedAmount := converted.amount
+	if err := s.policy.checkConverted(params, convertedAmount); err != nil {
+		return nil, err
+	}
+	fees := s.fees.apply(params, convertedAmount)
+	if err := fees.checkCovered(params); err != nil {
 		return nil, err
 	}
+	roundedAmount := fees.gross
+	convertedMoney, err := moneyFromDecimal(fees.net, params.target.Code)
+	if err != nil {
+		return nil, status.Errorf(codes.OutOfRange, "converted amount out of range: %v", err)
+	}
+	breakdown, err := fees.breakdown(params.target.Code)
+	if err != nil {
+		return nil, status.Errorf(codes.OutOfRange, "converted amount out of range: %v", err)
+	}
 
 	// Return the response with the converted amount
-	return &pb.ConvertResponse{ConvertedAmount: convertedAmount}, nil
+	res := &pb.ConvertResponse{
+		ConvertedAmount:    fees.net.InexactFloat64(),
+		ConvertedMoney:     convertedMoney,
+		UnroundedAmount:    convertedAmount.String(),
+		RoundingMode:       params.roundingMode,
+		AppliedRates:       converted.route.appliedRates(),
+		Route:              converted.route.kind,
+		Path:               converted.route.path(),
+		EffectiveRate:      converted.route.rate().String(),
+		RoundingDifference: roundedAmount.Sub(convertedAmount).String(),
+		Fees:               breakdown,
+		Side:               params.side,
+	}
+	if updated := converted.route.updatedAt(); !updated.IsZero() {
+		res.RatesUpdatedAt = timestamppb.New(updated)
+	}
+	if params.explain {
+		converted.route.applyTrace(params.amount, &res.Explain)
+		res.Explain = append(res.Explain, &pb.CalculationStep{
+			Description: fmt.Sprintf("round to %d decimal places (%s)", params.target.MinorUnits, params.roundingMode),
+			Operand:     convertedAmount.String(),
+			Result:      roundedAmount.String(),
+		})
+		if fees.charged {
+			res.Explain = append(res.Explain, &pb.CalculationStep{
+				Description: fees.stepDescription(params.side),
+				Operand:     fees.fee.String(),
+				Result:      fees.net.String(),
+			})
+		}
+	}
+	return res, nil
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		os.Exit(runReconcile(os.Args[2:], os.Stdout))
+	}
+
+	cfg, err := loadConfig(os.Args[1:], os.Getenv)
+	if err != nil {
+		log.Fatalf("failed to load configuration: %v", err)
+	}
+
 	// Initialize the database
-	db, err := initDB()
+	db, err := initDB(cfg.Database)
 	if err != nil {
 		log.Fatalf("failed to connect to database: %v", err)
 	}
 	defer db.Close()
 
-	// Create a listener on TCP port 50051
-	lis, err := net.Listen("tcp", ":50051")
+	// Create a listener on the configured address
+	lis, err := net.Listen("tcp", cfg.ListenAddress)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	// Create a new gRPC server
-	s := grpc.NewServer()
-	pb.RegisterCurrencyConverterServer(s, &server{db: db})
+	s := grpc.NewServer(grpc.UnaryInterceptor(timeoutInterceptor(cfg.RequestTimeout)))
+	store := newPostgresStore(db, cfg.BaseCurrency)
+	// Current rates are served from memory when the cache is enabled; historical lookups still query the database
+	var rates RateStore = store
+	if cfg.Cache.TTL > 0 {
+		rates = newCachedStore(store, cfg.Cache.cacheOptions())
+	}
+	srv := newServer(rates)
+	srv.policy = cfg.amountPolicy()
+	srv.paths = cfg.Routing.pathOptions()
+	srv.fees = cfg.Fees.Rules
+
+	// Rate changes are pushed to subscribers as soon as they are notified, or on the next poll otherwise
+	var notifier changeNotifier
+	if connStr, err := cfg.Database.connString(); err == nil {
+		if n, err := newPgNotifier(connStr); err != nil {
+			log.Printf("rate change notifications unavailable, falling back to polling: %v", err)
+		} else {
+			defer n.Close()
+			notifier = n
+		}
+	}
+	// Streams re-read the database so they see changes as soon as they are notified, not after the cache TTL
+	srv.hub = newRateHub(store, notifier, cfg.Streaming.streamOptions())
+	srv.quotes = newPostgresQuoteStore(db)
+	srv.quoteTTL = cfg.Quotes.TTL
+	srv.idempotency = newPostgresIdempotencyStore(db)
+	srv.idempotencyWindow = cfg.Idempotency.Window
+	ledger := newPostgresLedger(db)
+	srv.ledger = ledger
+	pb.RegisterCurrencyConverterServer(s, srv)
+	servers := []*grpc.Server{s}
+
+	// RateAdmin and ConversionLedger are off by default and can be kept off the public listener
+	if cfg.Admin.Enabled {
+		admin := newAdminServer(store)
+		if cfg.Admin.ListenAddress == "" {
+			pb.RegisterRateAdminServer(s, admin)
+			pb.RegisterConversionLedgerServer(s, newLedgerServer(ledger))
+		} else {
+			adminLis, err := net.Listen("tcp", cfg.Admin.ListenAddress)
+			if err != nil {
+				log.Fatalf("failed to listen for admin: %v", err)
+			}
+			as := grpc.NewServer(grpc.UnaryInterceptor(timeoutInterceptor(cfg.RequestTimeout)))
+			pb.RegisterRateAdminServer(as, admin)
+			pb.RegisterConversionLedgerServer(as, newLedgerServer(ledger))
+			servers = append(servers, as)
+			go func() {
+				log.Printf("admin server listening at %v", adminLis.Addr())
+				if err := as.Serve(adminLis); err != nil {
+					log.Fatalf("failed to serve admin: %v", err)
+				}
+			}()
+		}
+	}
+
+	go gracefulStop(cfg.ShutdownTimeout, servers...)
 
 	log.Printf("server listening at %v", lis.Addr())
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// gracefulStop drains in-flight RPCs on SIGINT/SIGTERM, forcing a stop after the timeout
+func gracefulStop(timeout time.Duration, servers ...*grpc.Server) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	<-sig
+	log.Printf("shutting down")
+
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for _, s := range servers {
+			wg.Add(1)
+			go func(s *grpc.Server) {
+				defer wg.Done()
+				s.GracefulStop()
+			}(s)
+		}
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		for _, s := range servers {
+			s.Stop()
+		}
+	}
+}
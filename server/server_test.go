@@ -3,13 +3,19 @@ package main
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"testing"
 	"time"
 
 	pb "CurrencyConverter/proto"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MockCurrencyConverterServer is a mock implementation of the CurrencyConverterServer interface
@@ -364,3 +370,378 @@ func TestConvertCurrencySimultaneousRequests(t *testing.T) {
 	wg.Wait()
 	mockServer.AssertExpectations(t)
 }
+
+func newTestServer() *server {
+	return newServer(newMemoryStore("INR", map[string]decimal.Decimal{
+		"USD": decimal.RequireFromString("83.12"),
+		"EUR": decimal.RequireFromString("90.45"),
+		"JPY": decimal.RequireFromString("0.5571"),
+		"KWD": decimal.RequireFromString("270.3456"),
+	}))
+}
+
+func TestConvertWithMemoryStore(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         100,
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 8312.0, res.ConvertedAmount)
+	assert.Equal(t, &pb.Money{CurrencyCode: "INR", Units: 8312}, res.ConvertedMoney)
+}
+
+func TestConvertWithMoneyIsExact(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// 0.1 + 0.2 style drift would show up in the nanos with float64 arithmetic
+	res, err := s.Convert(ctx, &pb.ConvertRequest{
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+		AmountMoney:    &pb.Money{CurrencyCode: "USD", Units: 0, Nanos: 300000000},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Money{CurrencyCode: "INR", Units: 24, Nanos: 940000000}, res.ConvertedMoney)
+}
+
+func TestConvertWithMemoryStoreUnknownCurrency(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         100,
+		SourceCurrency: "USD",
+		TargetCurrency: "GBP",
+	})
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "conversion rate not found for GBP", st.Message())
+}
+
+func TestConvertRejectsUnknownCurrencyBeforeLookup(t *testing.T) {
+	s := newServer(newMemoryStore("INR", nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	// A canceled context makes any store access fail with a different error
+	cancel()
+
+	_, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         100,
+		SourceCurrency: "XYZ",
+		TargetCurrency: "INR",
+	})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "unknown currency XYZ", st.Message())
+	if assert.Len(t, st.Details(), 1) {
+		badRequest := st.Details()[0].(*errdetails.BadRequest)
+		assert.Equal(t, "source_currency", badRequest.FieldViolations[0].Field)
+	}
+}
+
+func TestConvertRoundsToTargetMinorUnits(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         10,
+		SourceCurrency: "USD",
+		TargetCurrency: "JPY",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Money{CurrencyCode: "JPY", Units: 1492}, res.ConvertedMoney)
+
+	res, err = s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         10,
+		SourceCurrency: "USD",
+		TargetCurrency: "KWD",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Money{CurrencyCode: "KWD", Units: 3, Nanos: 75000000}, res.ConvertedMoney)
+	assert.Equal(t, 3.075, res.ConvertedAmount)
+}
+
+func TestConvertAppliesRequestedRoundingMode(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// 0.3 USD is 24.936 INR before rounding
+	req := &pb.ConvertRequest{
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+		AmountMoney:    &pb.Money{Nanos: 300000000},
+		RoundingMode:   pb.RoundingMode_ROUNDING_MODE_FLOOR,
+	}
+	res, err := s.Convert(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Money{CurrencyCode: "INR", Units: 24, Nanos: 930000000}, res.ConvertedMoney)
+	assert.Equal(t, "24.936", res.UnroundedAmount)
+	assert.Equal(t, pb.RoundingMode_ROUNDING_MODE_FLOOR, res.RoundingMode)
+
+	req.RoundingMode = pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED
+	res, err = s.Convert(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(940000000), res.ConvertedMoney.Nanos)
+	assert.Equal(t, pb.RoundingMode_ROUNDING_MODE_HALF_UP, res.RoundingMode)
+}
+
+func TestConvertRejectsResultOverflow(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Within the input limit, but 83.12 times larger once converted
+	_, err := s.Convert(ctx, &pb.ConvertRequest{
+		AmountMoney:    &pb.Money{Units: 1e14},
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestConvertRejectsNaN(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         math.NaN(),
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestConvertAsOfUsesHistoricalRates(t *testing.T) {
+	s := newTestServer()
+	store := s.store.(*memoryStore)
+	lastMonth := time.Now().AddDate(0, -1, 0).UTC()
+	store.SetAt("USD", decimal.RequireFromString("80"), lastMonth)
+	store.Set("USD", decimal.RequireFromString("84"))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         100,
+		SourceCurrency: "USD",
+		TargetCurrency: "EUR",
+		AsOf:           timestamppb.New(lastMonth.Add(time.Hour)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 88.45, res.ConvertedAmount)
+	if assert.Len(t, res.AppliedRates, 2) {
+		assert.Equal(t, "USD", res.AppliedRates[0].BaseCurrency)
+		assert.Equal(t, "INR", res.AppliedRates[0].QuoteCurrency)
+		assert.Equal(t, lastMonth, res.AppliedRates[0].EffectiveFrom.AsTime())
+		assert.Equal(t, time.Unix(0, 0).UTC(), res.AppliedRates[1].EffectiveFrom.AsTime())
+	}
+
+	res, err = s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         100,
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 8400.0, res.ConvertedAmount)
+}
+
+func TestConvertRejectsFutureAsOf(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.Convert(ctx, &pb.ConvertRequest{
+		Amount:         100,
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+		AsOf:           timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestConvertReportsRoute(t *testing.T) {
+	s := newTestServer()
+	s.store.(*memoryStore).SetPair("EUR", "USD", decimal.RequireFromString("1.0850"))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "USD"})
+	assert.NoError(t, err)
+	assert.Equal(t, 108.5, res.ConvertedAmount)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_DIRECT, res.Route)
+	assert.Len(t, res.AppliedRates, 1)
+
+	res, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "INR"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_PIVOT, res.Route)
+	// INR is the base currency and needs no rate of its own
+	assert.Len(t, res.AppliedRates, 1)
+}
+
+func TestConvertSameCurrencySkipsStore(t *testing.T) {
+	store := &countingStore{RateStore: newTestServer().store}
+	s := newServer(store)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 12.345, SourceCurrency: "USD", TargetCurrency: "USD"})
+	assert.NoError(t, err)
+	assert.Equal(t, 12.35, res.ConvertedAmount)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_IDENTITY, res.Route)
+	assert.Empty(t, res.AppliedRates)
+	assert.Equal(t, 0, store.lookups+store.allRates)
+}
+
+func TestConvertWithEURBase(t *testing.T) {
+	s := newServer(newMemoryStore("EUR", map[string]decimal.Decimal{
+		"USD": decimal.RequireFromString("0.92"),
+		"GBP": decimal.RequireFromString("1.17"),
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "USD", TargetCurrency: "EUR"})
+	assert.NoError(t, err)
+	assert.Equal(t, 92.0, res.ConvertedAmount)
+	assert.Equal(t, "EUR", res.AppliedRates[0].QuoteCurrency)
+
+	res, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 117, SourceCurrency: "GBP", TargetCurrency: "USD"})
+	assert.NoError(t, err)
+	assert.Equal(t, 148.79, res.ConvertedAmount)
+}
+
+func TestConvertFindsCrossRoute(t *testing.T) {
+	s := newTestServer()
+	// CHF has no conversion rate, only a pair against EUR
+	s.store.(*memoryStore).SetPair("CHF", "EUR", decimal.RequireFromString("1.05"))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "CHF", TargetCurrency: "USD"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ConversionRoute_CONVERSION_ROUTE_CROSS, res.Route)
+	assert.Equal(t, []string{"CHF", "EUR", "INR", "USD"}, res.Path)
+	assert.Len(t, res.AppliedRates, 3)
+	assert.Equal(t, 114.26, res.ConvertedAmount)
+
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "SEK", TargetCurrency: "USD"})
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "conversion rate not found for SEK", st.Message())
+}
+
+func TestConvertReportsRateDetails(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "USD"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.088185755534167469", res.EffectiveRate)
+	assert.Equal(t, time.Unix(0, 0).UTC(), res.RatesUpdatedAt.AsTime())
+	assert.Equal(t, "108.818575553416746872", res.UnroundedAmount)
+	assert.Equal(t, "0.001424446583253128", res.RoundingDifference)
+	assert.Empty(t, res.Explain)
+}
+
+func TestConvertExplain(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "USD", Explain: true})
+	assert.NoError(t, err)
+	var steps [][3]string
+	for _, step := range res.Explain {
+		steps = append(steps, [3]string{step.Description, step.Operand, step.Result})
+	}
+	assert.Equal(t, [][3]string{
+		{"amount in EUR", "100", "100"},
+		{"multiply amount by EUR/INR rate", "90.45", "9045"},
+		{"multiply divisor by USD/INR rate", "83.12", "83.12"},
+		{"divide amount by divisor, rounded to 18 decimal places", "83.12", "108.818575553416746872"},
+		{"round to 2 decimal places (ROUNDING_MODE_HALF_UP)", "108.818575553416746872", "108.82"},
+	}, steps)
+	assert.Equal(t, res.UnroundedAmount, res.Explain[3].Result)
+}
+
+func TestConvertExplainSameCurrency(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 12.345, SourceCurrency: "USD", TargetCurrency: "USD", Explain: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", res.EffectiveRate)
+	assert.Nil(t, res.RatesUpdatedAt)
+	assert.Len(t, res.Explain, 2)
+}
+
+// newSpreadTestServer publishes a bid and ask around the USD and EUR rates of newTestServer
+func newSpreadTestServer() *server {
+	s := newTestServer()
+	store := s.store.(*memoryStore)
+	store.Put(Rate{Base: "USD", Quote: "INR", Value: decimal.RequireFromString("83.12"),
+		Bid: decimal.RequireFromString("83.00"), Ask: decimal.RequireFromString("83.24"), EffectiveFrom: time.Unix(1, 0)})
+	store.Put(Rate{Base: "EUR", Quote: "INR", Value: decimal.RequireFromString("90.45"),
+		Bid: decimal.RequireFromString("90.30"), Ask: decimal.RequireFromString("90.60"), EffectiveFrom: time.Unix(1, 0)})
+	return s
+}
+
+func TestConvertSides(t *testing.T) {
+	s := newSpreadTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for _, tc := range []struct {
+		source, target string
+		side           pb.Side
+		want           string
+	}{
+		{"USD", "INR", pb.Side_SIDE_UNSPECIFIED, "8312"},
+		{"USD", "INR", pb.Side_SIDE_BUY, "8300"},
+		{"USD", "INR", pb.Side_SIDE_SELL, "8324"},
+		{"EUR", "USD", pb.Side_SIDE_UNSPECIFIED, "108.818575553416746872"},
+		{"EUR", "USD", pb.Side_SIDE_BUY, "108.481499279192695819"},
+		{"EUR", "USD", pb.Side_SIDE_SELL, "109.156626506024096386"},
+		// JPY only has a mid rate
+		{"JPY", "INR", pb.Side_SIDE_BUY, "55.71"},
+	} {
+		res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: tc.source, TargetCurrency: tc.target, Side: tc.side})
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.want, res.UnroundedAmount, "%s->%s %s", tc.source, tc.target, tc.side)
+			assert.Equal(t, tc.side, res.Side)
+		}
+	}
+}
+
+func TestConvertSideReportsAppliedPrices(t *testing.T) {
+	s := newSpreadTestServer()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "USD", Side: pb.Side_SIDE_BUY, Explain: true})
+	assert.NoError(t, err)
+	if assert.Len(t, res.AppliedRates, 2) {
+		assert.Equal(t, "90.3", res.AppliedRates[0].Rate)
+		assert.Equal(t, "90.3", res.AppliedRates[0].Bid)
+		assert.Equal(t, "90.6", res.AppliedRates[0].Ask)
+		assert.Equal(t, "83.24", res.AppliedRates[1].Rate)
+	}
+	assert.Equal(t, "1.084814992791926958", res.EffectiveRate)
+	if assert.True(t, len(res.Explain) > 2) {
+		assert.Equal(t, "multiply amount by EUR/INR bid rate", res.Explain[1].Description)
+		assert.Equal(t, "multiply divisor by USD/INR ask rate", res.Explain[2].Description)
+	}
+
+	_, err = s.Convert(ctx, &pb.ConvertRequest{Amount: 100, SourceCurrency: "EUR", TargetCurrency: "USD", Side: pb.Side(7)})
+	assert.Equal(t, []string{"side"}, violatedFields(t, err))
+}
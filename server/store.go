@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrRateNotFound is returned by a RateStore when no rate exists for a currency or pair
+var ErrRateNotFound = errors.New("conversion rate not found")
+
+// ErrRateConflict is returned by a RateWriter for a change at an instant that already has one.
+// Rate history is only ever appended to, so earlier conversions can always be reproduced.
+var ErrRateConflict = errors.New("rate change already recorded at this instant")
+
+// rateConflict describes the change that conflicted with the history
+func rateConflict(c RateChange) error {
+	return fmt.Errorf("%w: %s/%s at %s", ErrRateConflict, c.Base, c.Quote, c.EffectiveFrom.UTC().Format(time.RFC3339Nano))
+}
+
+// Rate is a quote of one unit of Base in Quote, effective from a point in time
+type Rate struct {
+	Base          string
+	Quote         string
+	Value         decimal.Decimal
+	EffectiveFrom time.Time
+	// Provider names the source the rate was published by
+	Provider string
+	// Bid and Ask are the prices one unit of Base is bought and sold at; zero when only
+	// the mid rate Value is published
+	Bid decimal.Decimal
+	Ask decimal.Decimal
+}
+
+// bid returns the published bid, or the mid rate when there is none
+func (r Rate) bid() decimal.Decimal {
+	if r.Bid.IsZero() {
+		return r.Value
+	}
+	return r.Bid
+}
+
+// ask returns the published ask, or the mid rate when there is none
+func (r Rate) ask() decimal.Decimal {
+	if r.Ask.IsZero() {
+		return r.Value
+	}
+	return r.Ask
+}
+
+// defaultBaseCurrency is the pivot currency used when none is configured
+const defaultBaseCurrency = "INR"
+
+// defaultProvider is recorded for rates entered without naming their source
+const defaultProvider = "manual"
+
+// RateStore provides pivot rates relative to its base currency and direct currency pair rates.
+// A zero asOf selects the current rates; otherwise the rates in effect at asOf are returned.
+type RateStore interface {
+	// Base returns the currency every pivot rate is quoted against
+	Base() string
+	// Rate returns the pivot rate for a single currency
+	Rate(ctx context.Context, currency string, asOf time.Time) (Rate, error)
+	// Pair returns the direct rate quoted between two currencies in either direction,
+	// preferring the one quoted as source/target
+	Pair(ctx context.Context, source, target string, asOf time.Time) (Rate, error)
+	// AllRates returns every pivot and pair rate in effect at asOf
+	AllRates(ctx context.Context, asOf time.Time) ([]Rate, error)
+}
+
+// RateChange is an entry in the history of a rate: a new value, or the removal of the rate
+type RateChange struct {
+	Rate
+	// Deleted marks the rate as unavailable from EffectiveFrom; Value and Provider are then unused
+	Deleted bool
+	// RecordedBy identifies who made the change
+	RecordedBy string
+	RecordedAt time.Time
+}
+
+// RateWriter is a RateStore whose rates can be changed. A change quoted against Base() is a pivot
+// rate, any other is a pair rate. A change with a zero EffectiveFrom takes effect immediately.
+type RateWriter interface {
+	RateStore
+	// WriteRates records every change in a single transaction and returns them as stored
+	WriteRates(ctx context.Context, changes []RateChange) ([]RateChange, error)
+	// RateHistory returns up to limit changes of a rate, newest first, that take effect before
+	// the given instant; a zero before returns the newest changes
+	RateHistory(ctx context.Context, base, quote string, before time.Time, limit int) ([]RateChange, error)
+}
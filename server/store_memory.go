@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// pairKey identifies a directly quoted currency pair
+type pairKey struct {
+	base, quote string
+}
+
+// memoryStore keeps the rate history in memory, for tests and local development
+type memoryStore struct {
+	base string
+
+	mu sync.RWMutex
+	// rates and pairs hold each pivot rate and pair rate history ordered by EffectiveFrom
+	rates map[string][]RateChange
+	pairs map[pairKey][]RateChange
+
+	changes chan struct{}
+}
+
+// newMemoryStore seeds the store with pivot rates against base, effective from the Unix epoch
+func newMemoryStore(base string, rates map[string]decimal.Decimal) *memoryStore {
+	m := &memoryStore{
+		base:    base,
+		rates:   make(map[string][]RateChange, len(rates)),
+		pairs:   make(map[pairKey][]RateChange),
+		changes: make(chan struct{}, 1),
+	}
+	for currency, rate := range rates {
+		m.SetAt(currency, rate, time.Unix(0, 0).UTC())
+	}
+	return m
+}
+
+// Set records a new pivot rate for a currency, effective immediately
+func (m *memoryStore) Set(currency string, rate decimal.Decimal) {
+	m.SetAt(currency, rate, time.Now())
+}
+
+// SetAt records a pivot rate for a currency effective from the given time
+func (m *memoryStore) SetAt(currency string, rate decimal.Decimal, effectiveFrom time.Time) {
+	m.Put(Rate{Base: currency, Quote: m.base, Value: rate, EffectiveFrom: effectiveFrom, Provider: defaultProvider})
+}
+
+// SetPair records a new direct rate for a currency pair, effective immediately
+func (m *memoryStore) SetPair(base, quote string, rate decimal.Decimal) {
+	m.SetPairAt(base, quote, rate, time.Now())
+}
+
+// SetPairAt records a direct rate for a currency pair effective from the given time
+func (m *memoryStore) SetPairAt(base, quote string, rate decimal.Decimal, effectiveFrom time.Time) {
+	m.Put(Rate{Base: base, Quote: quote, Value: rate, EffectiveFrom: effectiveFrom, Provider: defaultProvider})
+}
+
+// Put records r as given: as a pivot rate when quoted against the base currency, otherwise as a pair rate.
+// Like WriteRates it never replaces a change, so seeding a rate twice at the same instant panics.
+func (m *memoryStore) Put(r Rate) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	defer m.notify()
+	c := RateChange{Rate: r, RecordedAt: time.Now()}
+	if !m.put(c) {
+		panic(rateConflict(c))
+	}
+}
+
+// history returns the history a change belongs to
+func (m *memoryStore) history(c RateChange) []RateChange {
+	if c.Quote == m.base {
+		return m.rates[c.Base]
+	}
+	return m.pairs[pairKey{c.Base, c.Quote}]
+}
+
+// put records c, unless its rate already has a change at the same instant
+func (m *memoryStore) put(c RateChange) bool {
+	history, ok := insertRate(m.history(c), c)
+	if !ok {
+		return false
+	}
+	if c.Quote == m.base {
+		m.rates[c.Base] = history
+	} else {
+		m.pairs[pairKey{c.Base, c.Quote}] = history
+	}
+	return true
+}
+
+// Changes signals after each Put
+func (m *memoryStore) Changes() <-chan struct{} {
+	return m.changes
+}
+
+func (m *memoryStore) notify() {
+	select {
+	case m.changes <- struct{}{}:
+	default:
+	}
+}
+
+// insertRate adds c to a history ordered by EffectiveFrom. History is never rewritten, so a change
+// at an instant that already has one is refused.
+func insertRate(history []RateChange, c RateChange) ([]RateChange, bool) {
+	i := sort.Search(len(history), func(i int) bool { return !history[i].EffectiveFrom.Before(c.EffectiveFrom) })
+	if i < len(history) && history[i].EffectiveFrom.Equal(c.EffectiveFrom) {
+		return history, false
+	}
+	history = append(history, RateChange{})
+	copy(history[i+1:], history[i:])
+	history[i] = c
+	return history, true
+}
+
+// rateAt returns the latest rate in a history effective at asOf, unless it has been deleted
+func rateAt(history []RateChange, asOf time.Time) (Rate, bool) {
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	i := sort.Search(len(history), func(i int) bool { return history[i].EffectiveFrom.After(asOf) })
+	if i == 0 || history[i-1].Deleted {
+		return Rate{}, false
+	}
+	return history[i-1].Rate, true
+}
+
+func (m *memoryStore) Base() string {
+	return m.base
+}
+
+func (m *memoryStore) Rate(ctx context.Context, currency string, asOf time.Time) (Rate, error) {
+	if err := ctx.Err(); err != nil {
+		return Rate{}, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	rate, ok := rateAt(m.rates[currency], asOf)
+	if !ok {
+		return Rate{}, fmt.Errorf("%w for %s", ErrRateNotFound, currency)
+	}
+	return rate, nil
+}
+
+func (m *memoryStore) Pair(ctx context.Context, source, target string, asOf time.Time) (Rate, error) {
+	if err := ctx.Err(); err != nil {
+		return Rate{}, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if rate, ok := rateAt(m.pairs[pairKey{source, target}], asOf); ok {
+		return rate, nil
+	}
+	if rate, ok := rateAt(m.pairs[pairKey{target, source}], asOf); ok {
+		return rate, nil
+	}
+	return Rate{}, fmt.Errorf("%w for %s/%s", ErrRateNotFound, source, target)
+}
+
+func (m *memoryStore) AllRates(ctx context.Context, asOf time.Time) ([]Rate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var rates []Rate
+	for _, history := range m.rates {
+		if rate, ok := rateAt(history, asOf); ok {
+			rates = append(rates, rate)
+		}
+	}
+	for _, history := range m.pairs {
+		if rate, ok := rateAt(history, asOf); ok {
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+func (m *memoryStore) WriteRates(ctx context.Context, changes []RateChange) ([]RateChange, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	defer m.notify()
+	now := time.Now()
+	stored := make([]RateChange, len(changes))
+	for i, c := range changes {
+		if c.EffectiveFrom.IsZero() {
+			c.EffectiveFrom = now
+		}
+		c.RecordedAt = now
+		stored[i] = c
+	}
+	// Check every change before recording any, so a conflict leaves the store untouched
+	seen := make(map[pairKey][]RateChange)
+	for _, c := range stored {
+		key := pairKey{c.Base, c.Quote}
+		if _, ok := seen[key]; !ok {
+			seen[key] = append([]RateChange(nil), m.history(c)...)
+		}
+		history, ok := insertRate(seen[key], c)
+		if !ok {
+			return nil, rateConflict(c)
+		}
+		seen[key] = history
+	}
+	for _, c := range stored {
+		m.put(c)
+	}
+	return stored, nil
+}
+
+func (m *memoryStore) RateHistory(ctx context.Context, base, quote string, before time.Time, limit int) ([]RateChange, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	history := m.pairs[pairKey{base, quote}]
+	if quote == m.base {
+		history = m.rates[base]
+	}
+	var changes []RateChange
+	for i := len(history) - 1; i >= 0 && len(changes) < limit; i-- {
+		if before.IsZero() || history[i].EffectiveFrom.Before(before) {
+			changes = append(changes, history[i])
+		}
+	}
+	return changes, nil
+}
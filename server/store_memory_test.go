@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStoreRate(t *testing.T) {
+	store := newMemoryStore("INR", map[string]decimal.Decimal{"USD": decimal.NewFromInt(75)})
+
+	rate, err := store.Rate(context.Background(), "USD", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "75", rate.Value.String())
+	assert.Equal(t, time.Unix(0, 0).UTC(), rate.EffectiveFrom)
+
+	_, err = store.Rate(context.Background(), "EUR", time.Time{})
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestMemoryStoreRateAsOf(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	store := newMemoryStore("INR", nil)
+	store.SetAt("USD", decimal.NewFromInt(83), day(10))
+	store.SetAt("USD", decimal.NewFromInt(82), day(1))
+	store.SetAt("USD", decimal.NewFromInt(84), day(20))
+
+	tests := []struct {
+		asOf     time.Time
+		expected string
+	}{
+		{day(1), "82"},
+		{day(15), "83"},
+		{day(20), "84"},
+		{time.Time{}, "84"},
+	}
+	for _, tt := range tests {
+		rate, err := store.Rate(context.Background(), "USD", tt.asOf)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, rate.Value.String(), tt.asOf.String())
+	}
+
+	_, err := store.Rate(context.Background(), "USD", day(1).Add(-time.Second))
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	rate, err := store.Rate(context.Background(), "USD", day(12))
+	assert.NoError(t, err)
+	assert.Equal(t, day(10), rate.EffectiveFrom)
+}
+
+func TestMemoryStoreCanceledContext(t *testing.T) {
+	store := newMemoryStore("INR", map[string]decimal.Decimal{"USD": decimal.NewFromInt(75)})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := store.Rate(ctx, "USD", time.Time{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMemoryStorePairEitherDirection(t *testing.T) {
+	store := newMemoryStore("INR", nil)
+	store.SetPairAt("EUR", "USD", decimal.RequireFromString("1.08"), time.Unix(0, 0))
+
+	rate, err := store.Pair(context.Background(), "USD", "EUR", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", rate.Base)
+
+	store.SetPairAt("USD", "EUR", decimal.RequireFromString("0.92"), time.Unix(0, 0))
+	rate, err = store.Pair(context.Background(), "USD", "EUR", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", rate.Base)
+
+	_, err = store.Pair(context.Background(), "USD", "GBP", time.Time{})
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
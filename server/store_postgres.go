@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// postgresStore reads current rates from the conversion_rates and currency_pairs views and
+// historical rates from the conversion_rate_history and currency_pair_history tables.
+// A history row with a NULL rate records that the rate was deleted.
+type postgresStore struct {
+	db   *sql.DB
+	base string
+}
+
+// newPostgresStore reads a dataset whose conversion_rates are quoted against base
+func newPostgresStore(db *sql.DB, base string) *postgresStore {
+	return &postgresStore{db: db, base: base}
+}
+
+func (p *postgresStore) Base() string {
+	return p.base
+}
+
+func (p *postgresStore) Rate(ctx context.Context, currency string, asOf time.Time) (Rate, error) {
+	var row *sql.Row
+	if asOf.IsZero() {
+		row = p.db.QueryRowContext(ctx, "SELECT rate, bid, ask, effective_from, provider FROM conversion_rates WHERE currency = $1", currency)
+	} else {
+		row = p.db.QueryRowContext(ctx, `SELECT rate, bid, ask, effective_from, provider FROM conversion_rate_history
+			WHERE currency = $1 AND effective_from <= $2
+			ORDER BY effective_from DESC LIMIT 1`, currency, asOf)
+	}
+
+	rate := Rate{Base: currency, Quote: p.base}
+	var value, bid, ask decimal.NullDecimal
+	err := row.Scan(&value, &bid, &ask, &rate.EffectiveFrom, &rate.Provider)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && !value.Valid {
+		return Rate{}, fmt.Errorf("%w for %s", ErrRateNotFound, currency)
+	}
+	if err != nil {
+		return Rate{}, err
+	}
+	rate.Value, rate.Bid, rate.Ask = value.Decimal, bid.Decimal, ask.Decimal
+	return rate, nil
+}
+
+func (p *postgresStore) Pair(ctx context.Context, source, target string, asOf time.Time) (Rate, error) {
+	var row *sql.Row
+	if asOf.IsZero() {
+		row = p.db.QueryRowContext(ctx, `SELECT base_currency, quote_currency, rate, bid, ask, effective_from, provider FROM currency_pairs
+			WHERE (base_currency = $1 AND quote_currency = $2) OR (base_currency = $2 AND quote_currency = $1)
+			ORDER BY base_currency = $1 DESC LIMIT 1`, source, target)
+	} else {
+		row = p.db.QueryRowContext(ctx, `SELECT base_currency, quote_currency, rate, bid, ask, effective_from, provider FROM (
+				SELECT DISTINCT ON (base_currency, quote_currency) base_currency, quote_currency, rate, bid, ask, effective_from, provider
+				FROM currency_pair_history
+				WHERE ((base_currency = $1 AND quote_currency = $2) OR (base_currency = $2 AND quote_currency = $1))
+					AND effective_from <= $3
+				ORDER BY base_currency, quote_currency, effective_from DESC
+			) AS pairs
+			WHERE rate IS NOT NULL
+			ORDER BY base_currency = $1 DESC LIMIT 1`, source, target, asOf)
+	}
+
+	var rate Rate
+	var bid, ask decimal.NullDecimal
+	err := row.Scan(&rate.Base, &rate.Quote, &rate.Value, &bid, &ask, &rate.EffectiveFrom, &rate.Provider)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Rate{}, fmt.Errorf("%w for %s/%s", ErrRateNotFound, source, target)
+	}
+	if err != nil {
+		return Rate{}, err
+	}
+	rate.Bid, rate.Ask = bid.Decimal, ask.Decimal
+	return rate, nil
+}
+
+func (p *postgresStore) AllRates(ctx context.Context, asOf time.Time) ([]Rate, error) {
+	var rows *sql.Rows
+	var err error
+	if asOf.IsZero() {
+		rows, err = p.db.QueryContext(ctx, `SELECT currency, NULL, rate, bid, ask, effective_from, provider FROM conversion_rates
+			UNION ALL
+			SELECT base_currency, quote_currency, rate, bid, ask, effective_from, provider FROM currency_pairs`)
+	} else {
+		rows, err = p.db.QueryContext(ctx, `SELECT * FROM (
+				SELECT DISTINCT ON (currency) currency, NULL, rate, bid, ask, effective_from, provider FROM conversion_rate_history
+				WHERE effective_from <= $1
+				ORDER BY currency, effective_from DESC
+			) AS rates
+			UNION ALL
+			SELECT * FROM (
+				SELECT DISTINCT ON (base_currency, quote_currency) base_currency, quote_currency, rate, bid, ask, effective_from, provider
+				FROM currency_pair_history
+				WHERE effective_from <= $1
+				ORDER BY base_currency, quote_currency, effective_from DESC
+			) AS pairs`, asOf)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []Rate
+	for rows.Next() {
+		var rate Rate
+		var quote sql.NullString
+		var value, bid, ask decimal.NullDecimal
+		if err := rows.Scan(&rate.Base, &quote, &value, &bid, &ask, &rate.EffectiveFrom, &rate.Provider); err != nil {
+			return nil, err
+		}
+		if !value.Valid {
+			continue
+		}
+		rate.Value, rate.Bid, rate.Ask = value.Decimal, bid.Decimal, ask.Decimal
+		// Pivot rates have no quote currency column
+		rate.Quote = p.base
+		if quote.Valid {
+			rate.Quote = quote.String
+		}
+		rates = append(rates, rate)
+	}
+	return rates, rows.Err()
+}
+
+func (p *postgresStore) WriteRates(ctx context.Context, changes []RateChange) ([]RateChange, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	stored := make([]RateChange, len(changes))
+	for i, c := range changes {
+		value := decimal.NullDecimal{Decimal: c.Value, Valid: !c.Deleted}
+		bid := decimal.NullDecimal{Decimal: c.Bid, Valid: !c.Deleted && !c.Bid.IsZero()}
+		ask := decimal.NullDecimal{Decimal: c.Ask, Valid: !c.Deleted && !c.Ask.IsZero()}
+		effectiveFrom := sql.NullTime{Time: c.EffectiveFrom, Valid: !c.EffectiveFrom.IsZero()}
+		var row *sql.Row
+		if c.Quote == p.base {
+			row = tx.QueryRowContext(ctx, `INSERT INTO conversion_rate_history (currency, rate, bid, ask, effective_from, provider, recorded_by)
+				VALUES ($1, $2, $3, $4, COALESCE($5, now()), $6, $7)
+				ON CONFLICT (currency, effective_from) DO NOTHING
+				RETURNING effective_from, recorded_at`, c.Base, value, bid, ask, effectiveFrom, c.Provider, c.RecordedBy)
+		} else {
+			row = tx.QueryRowContext(ctx, `INSERT INTO currency_pair_history (base_currency, quote_currency, rate, bid, ask, effective_from, provider, recorded_by)
+				VALUES ($1, $2, $3, $4, $5, COALESCE($6, now()), $7, $8)
+				ON CONFLICT (base_currency, quote_currency, effective_from) DO NOTHING
+				RETURNING effective_from, recorded_at`, c.Base, c.Quote, value, bid, ask, effectiveFrom, c.Provider, c.RecordedBy)
+		}
+		// History rows are never updated; a conflicting row inserts nothing and returns no row
+		err := row.Scan(&c.EffectiveFrom, &c.RecordedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, rateConflict(c)
+		}
+		if err != nil {
+			return nil, err
+		}
+		stored[i] = c
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func (p *postgresStore) RateHistory(ctx context.Context, base, quote string, before time.Time, limit int) ([]RateChange, error) {
+	beforeTime := sql.NullTime{Time: before, Valid: !before.IsZero()}
+	var rows *sql.Rows
+	var err error
+	if quote == p.base {
+		rows, err = p.db.QueryContext(ctx, `SELECT rate, bid, ask, effective_from, provider, recorded_by, recorded_at FROM conversion_rate_history
+			WHERE currency = $1 AND ($2::timestamptz IS NULL OR effective_from < $2)
+			ORDER BY effective_from DESC LIMIT $3`, base, beforeTime, limit)
+	} else {
+		rows, err = p.db.QueryContext(ctx, `SELECT rate, bid, ask, effective_from, provider, recorded_by, recorded_at FROM currency_pair_history
+			WHERE base_currency = $1 AND quote_currency = $2 AND ($3::timestamptz IS NULL OR effective_from < $3)
+			ORDER BY effective_from DESC LIMIT $4`, base, quote, beforeTime, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []RateChange
+	for rows.Next() {
+		c := RateChange{Rate: Rate{Base: base, Quote: quote}}
+		var value, bid, ask decimal.NullDecimal
+		if err := rows.Scan(&value, &bid, &ask, &c.EffectiveFrom, &c.Provider, &c.RecordedBy, &c.RecordedAt); err != nil {
+			return nil, err
+		}
+		c.Value, c.Bid, c.Ask, c.Deleted = value.Decimal, bid.Decimal, ask.Decimal, !value.Valid
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
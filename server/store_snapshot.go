@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// snapshotStore serves a fixed set of rates fetched from another store in a single query,
+// so every lookup made against it sees the same rates. The asOf of each lookup is ignored:
+// the snapshot was already taken at the instant of interest.
+type snapshotStore struct {
+	base  string
+	all   []Rate
+	rates map[string]Rate
+	pairs map[pairKey]Rate
+
+	graphOnce sync.Once
+	graph     *rateGraph
+}
+
+// graphStore is a store that can share one rate graph between every cross lookup against the same rates
+type graphStore interface {
+	RateGraph(ctx context.Context, asOf time.Time) (*rateGraph, error)
+}
+
+// takeSnapshot fetches every rate in effect at asOf from store
+func takeSnapshot(ctx context.Context, store RateStore, asOf time.Time) (*snapshotStore, error) {
+	rates, err := store.AllRates(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return newSnapshotStore(store.Base(), rates), nil
+}
+
+func newSnapshotStore(base string, rates []Rate) *snapshotStore {
+	s := &snapshotStore{
+		base:  base,
+		all:   rates,
+		rates: make(map[string]Rate),
+		pairs: make(map[pairKey]Rate),
+	}
+	for _, r := range rates {
+		if r.Quote == base {
+			s.rates[r.Base] = r
+		} else {
+			s.pairs[pairKey{r.Base, r.Quote}] = r
+		}
+	}
+	return s
+}
+
+func (s *snapshotStore) Base() string {
+	return s.base
+}
+
+func (s *snapshotStore) Rate(ctx context.Context, currency string, _ time.Time) (Rate, error) {
+	if err := ctx.Err(); err != nil {
+		return Rate{}, err
+	}
+	rate, ok := s.rates[currency]
+	if !ok {
+		return Rate{}, fmt.Errorf("%w for %s", ErrRateNotFound, currency)
+	}
+	return rate, nil
+}
+
+func (s *snapshotStore) Pair(ctx context.Context, source, target string, _ time.Time) (Rate, error) {
+	if err := ctx.Err(); err != nil {
+		return Rate{}, err
+	}
+	if rate, ok := s.pairs[pairKey{source, target}]; ok {
+		return rate, nil
+	}
+	if rate, ok := s.pairs[pairKey{target, source}]; ok {
+		return rate, nil
+	}
+	return Rate{}, fmt.Errorf("%w for %s/%s", ErrRateNotFound, source, target)
+}
+
+func (s *snapshotStore) AllRates(ctx context.Context, _ time.Time) ([]Rate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.all, nil
+}
+
+// RateGraph returns the graph of the snapshot's rates, built on first use
+func (s *snapshotStore) RateGraph(ctx context.Context, _ time.Time) (*rateGraph, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.graphOnce.Do(func() { s.graph = newRateGraph(s.all) })
+	return s.graph, nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// maxSubscribedPairs caps the number of pairs in a single SubscribeRates call
+const maxSubscribedPairs = 100
+
+// SubscribeRates implements the gRPC method streaming rate changes for a set of currency pairs
+func (s *server) SubscribeRates(req *pb.SubscribeRatesRequest, stream pb.CurrencyConverter_SubscribeRatesServer) error {
+	pairs, err := validateSubscribeRatesRequest(req)
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+
+	// Subscribe before taking the initial snapshot so a change in between is not missed
+	sub := s.hub.subscribe()
+	defer s.hub.unsubscribe(sub)
+
+	// Read from the hub's store, which bypasses the rate cache, so updates never precede the snapshot
+	snapshot, err := takeSnapshot(ctx, s.hub.store, time.Time{})
+	if err != nil {
+		log.Printf("Error retrieving rate snapshot: %v", err)
+		return rateLookupError("subscription", err)
+	}
+	initial := s.withStore(snapshot)
+	rates := make([]*pb.GetRateResponse, len(pairs))
+	for i, p := range pairs {
+		rt, err := initial.resolveRoute(ctx, p.source.Code, p.target.Code, time.Time{})
+		if err != nil {
+			return err
+		}
+		rates[i] = pairRate(p.source.Code, p.target.Code, rt)
+	}
+	if err := stream.Send(&pb.SubscribeRatesResponse{
+		Event: &pb.SubscribeRatesResponse_Snapshot{Snapshot: &pb.RateSnapshot{Rates: rates}},
+	}); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(s.hub.opts.HeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-heartbeat.C:
+			if err := stream.Send(&pb.SubscribeRatesResponse{
+				Event: &pb.SubscribeRatesResponse_Heartbeat{Heartbeat: &pb.Heartbeat{SentAt: timestamppb.Now()}},
+			}); err != nil {
+				return err
+			}
+		case snapshot := <-sub.snapshots:
+			latest := s.withStore(snapshot)
+			for i, p := range pairs {
+				rt, err := latest.resolveRoute(ctx, p.source.Code, p.target.Code, time.Time{})
+				if err != nil {
+					// Keep the last known rate until the pair can be priced again
+					continue
+				}
+				rate := pairRate(p.source.Code, p.target.Code, rt)
+				if proto.Equal(rate, rates[i]) {
+					continue
+				}
+				rates[i] = rate
+				if err := stream.Send(&pb.SubscribeRatesResponse{
+					Event: &pb.SubscribeRatesResponse_Update{Update: rate},
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
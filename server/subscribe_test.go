@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+// fakeRateStream collects the messages sent on a SubscribeRates stream
+type fakeRateStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.SubscribeRatesResponse
+}
+
+func newFakeRateStream(ctx context.Context) *fakeRateStream {
+	return &fakeRateStream{ctx: ctx, sent: make(chan *pb.SubscribeRatesResponse, 16)}
+}
+
+func (f *fakeRateStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeRateStream) Send(res *pb.SubscribeRatesResponse) error {
+	f.sent <- res
+	return nil
+}
+
+func (f *fakeRateStream) next(t *testing.T) *pb.SubscribeRatesResponse {
+	t.Helper()
+	select {
+	case res := <-f.sent:
+		return res
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+		return nil
+	}
+}
+
+func subscribe(s *server, stream *fakeRateStream, pairs ...*pb.CurrencyPair) chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- s.SubscribeRates(&pb.SubscribeRatesRequest{Pairs: pairs}, stream)
+	}()
+	return done
+}
+
+func TestSubscribeRatesSnapshotThenUpdates(t *testing.T) {
+	s := newTestServer()
+	store := s.store.(*memoryStore)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newFakeRateStream(ctx)
+
+	done := subscribe(s, stream, &pb.CurrencyPair{SourceCurrency: "USD", TargetCurrency: "INR"}, &pb.CurrencyPair{SourceCurrency: "EUR", TargetCurrency: "JPY"})
+	snapshot := stream.next(t).GetSnapshot()
+	if assert.NotNil(t, snapshot) && assert.Len(t, snapshot.Rates, 2) {
+		assert.Equal(t, "83.12", snapshot.Rates[0].Rate)
+		assert.Equal(t, "EUR", snapshot.Rates[1].SourceCurrency)
+	}
+
+	store.Set("USD", decimal.RequireFromString("83.50"))
+	update := stream.next(t).GetUpdate()
+	if assert.NotNil(t, update) {
+		assert.Equal(t, "USD", update.SourceCurrency)
+		assert.Equal(t, "83.5", update.Rate)
+	}
+
+	// Unrelated currencies produce no update
+	store.Set("KWD", decimal.RequireFromString("271"))
+	store.Set("EUR", decimal.RequireFromString("91"))
+	update = stream.next(t).GetUpdate()
+	if assert.NotNil(t, update) {
+		assert.Equal(t, "EUR", update.SourceCurrency)
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	s.hub.mu.Lock()
+	assert.Empty(t, s.hub.subs)
+	assert.Nil(t, s.hub.stop)
+	s.hub.mu.Unlock()
+}
+
+func TestSubscribeRatesSnapshotBypassesCache(t *testing.T) {
+	s, store, _ := newCachedTestServer(cacheOptions{TTL: time.Hour})
+	memory := store.RateStore.(*memoryStore)
+	s.hub = newRateHub(memory, notifierOf(memory), defaultStreamOptions())
+	assert.Equal(t, "8312", convertUSDToINR(t, s))
+	memory.Set("USD", decimal.RequireFromString("84"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newFakeRateStream(ctx)
+	subscribe(s, stream, &pb.CurrencyPair{SourceCurrency: "USD", TargetCurrency: "INR"})
+	snapshot := stream.next(t).GetSnapshot()
+	if assert.NotNil(t, snapshot) && assert.Len(t, snapshot.Rates, 1) {
+		assert.Equal(t, "84", snapshot.Rates[0].Rate)
+	}
+	// Conversions still use the cached rate
+	assert.Equal(t, "8312", convertUSDToINR(t, s))
+}
+
+func TestSubscribeRatesHeartbeat(t *testing.T) {
+	s := newTestServer()
+	s.hub = newRateHub(s.store, nil, streamOptions{PollInterval: time.Hour, HeartbeatInterval: 10 * time.Millisecond, BufferSize: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newFakeRateStream(ctx)
+
+	subscribe(s, stream, &pb.CurrencyPair{SourceCurrency: "USD", TargetCurrency: "EUR"})
+	assert.NotNil(t, stream.next(t).GetSnapshot())
+	assert.NotNil(t, stream.next(t).GetHeartbeat())
+}
+
+func TestSubscribeRatesErrors(t *testing.T) {
+	s := newTestServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := <-subscribe(s, newFakeRateStream(ctx))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = <-subscribe(s, newFakeRateStream(ctx), &pb.CurrencyPair{SourceCurrency: "USD", TargetCurrency: "XYZ"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = <-subscribe(s, newFakeRateStream(ctx), &pb.CurrencyPair{SourceCurrency: "USD", TargetCurrency: "GBP"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSubscriberDropsOldestSnapshot(t *testing.T) {
+	sub := &subscriber{snapshots: make(chan *snapshotStore, 2)}
+	first, second, third := newSnapshotStore("INR", nil), newSnapshotStore("INR", nil), newSnapshotStore("INR", nil)
+	sub.offer(first)
+	sub.offer(second)
+	sub.offer(third)
+
+	assert.Same(t, second, <-sub.snapshots)
+	assert.Same(t, third, <-sub.snapshots)
+}
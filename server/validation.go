@@ -0,0 +1,472 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "CurrencyConverter/proto"
+)
+
+// amountPolicy controls which amounts Convert accepts
+type amountPolicy struct {
+	// AllowNegative permits negative amounts, e.g. for refunds
+	AllowNegative bool
+	// MaxAmount caps the magnitude of both the requested and the converted amount; zero disables the check
+	MaxAmount decimal.Decimal
+}
+
+// defaultAmountPolicy allows refunds and keeps amounts well inside the range of Money units
+func defaultAmountPolicy() amountPolicy {
+	return amountPolicy{
+		AllowNegative: true,
+		MaxAmount:     decimal.New(1, 15),
+	}
+}
+
+// convertParams holds a validated ConvertRequest
+type convertParams struct {
+	amount       decimal.Decimal
+	amountField  string
+	source       Currency
+	target       Currency
+	roundingMode pb.RoundingMode
+	asOf         time.Time
+	explain      bool
+	side         pb.Side
+	// client is the caller's identity, used to pick its fee rule
+	client string
+}
+
+// validateConvertRequest checks every field of a ConvertRequest and reports all violations at once
+func (p amountPolicy) validateConvertRequest(req *pb.ConvertRequest) (convertParams, error) {
+	var params convertParams
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	params.amountField = "amount"
+	if req.GetAmountMoney() != nil {
+		params.amountField = "amount_money"
+	}
+	amount, err := requestAmount(req)
+	if err == nil {
+		err = p.checkAmount(amount)
+	}
+	if err != nil {
+		violations = append(violations, fieldViolation(params.amountField, err))
+	}
+	params.amount = amount
+
+	if params.source, err = lookupCurrency(req.GetSourceCurrency()); err != nil {
+		violations = append(violations, fieldViolation("source_currency", err))
+	}
+	if params.target, err = lookupCurrency(req.GetTargetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("target_currency", err))
+	}
+	if params.roundingMode, err = resolveRoundingMode(req.GetRoundingMode()); err != nil {
+		violations = append(violations, fieldViolation("rounding_mode", err))
+	}
+
+	if params.asOf, err = requestAsOf(req.GetAsOf()); err != nil {
+		violations = append(violations, fieldViolation("as_of", err))
+	}
+	params.explain = req.GetExplain()
+	if params.side, err = resolveSide(req.GetSide()); err != nil {
+		violations = append(violations, fieldViolation("side", err))
+	}
+
+	if len(violations) > 0 {
+		return params, invalidArgumentError(violations...)
+	}
+	return params, nil
+}
+
+// validateConvertToTargetRequest checks every field of a ConvertToTargetRequest. The returned
+// params hold the target amount, which must be positive.
+func (p amountPolicy) validateConvertToTargetRequest(req *pb.ConvertToTargetRequest) (convertParams, error) {
+	var params convertParams
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	params.amountField = "target_amount"
+	if req.GetTargetMoney() != nil {
+		params.amountField = "target_money"
+	}
+	amount, err := amountOf(req.GetTargetMoney(), req.GetTargetAmount(), req.GetTargetCurrency(), "target")
+	if err == nil && !amount.IsPositive() {
+		err = errors.New("target amount must be positive")
+	}
+	if err == nil {
+		err = p.checkAmount(amount)
+	}
+	if err != nil {
+		violations = append(violations, fieldViolation(params.amountField, err))
+	}
+	params.amount = amount
+
+	if params.source, err = lookupCurrency(req.GetSourceCurrency()); err != nil {
+		violations = append(violations, fieldViolation("source_currency", err))
+	}
+	if params.target, err = lookupCurrency(req.GetTargetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("target_currency", err))
+	} else if !amount.Equal(amount.Truncate(params.target.MinorUnits)) {
+		violations = append(violations, fieldViolation(params.amountField,
+			fmt.Errorf("target amount must have at most %d decimal places for %s", params.target.MinorUnits, params.target.Code)))
+	}
+	if params.roundingMode, err = resolveRoundingMode(req.GetRoundingMode()); err != nil {
+		violations = append(violations, fieldViolation("rounding_mode", err))
+	}
+	if params.asOf, err = requestAsOf(req.GetAsOf()); err != nil {
+		violations = append(violations, fieldViolation("as_of", err))
+	}
+	if params.side, err = resolveSide(req.GetSide()); err != nil {
+		violations = append(violations, fieldViolation("side", err))
+	}
+
+	if len(violations) > 0 {
+		return params, invalidArgumentError(violations...)
+	}
+	return params, nil
+}
+
+// resolveSide rejects sides this build does not know
+func resolveSide(side pb.Side) (pb.Side, error) {
+	if _, ok := pb.Side_name[int32(side)]; !ok {
+		return side, fmt.Errorf("unknown side %d", side)
+	}
+	return side, nil
+}
+
+// rateParams holds a validated GetRateRequest
+type rateParams struct {
+	source Currency
+	target Currency
+	asOf   time.Time
+}
+
+// validateGetRateRequest checks the currencies and as_of of a GetRateRequest
+func validateGetRateRequest(req *pb.GetRateRequest) (rateParams, error) {
+	var params rateParams
+	var violations []*errdetails.BadRequest_FieldViolation
+	var err error
+
+	if params.source, err = lookupCurrency(req.GetSourceCurrency()); err != nil {
+		violations = append(violations, fieldViolation("source_currency", err))
+	}
+	if params.target, err = lookupCurrency(req.GetTargetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("target_currency", err))
+	}
+	if params.asOf, err = requestAsOf(req.GetAsOf()); err != nil {
+		violations = append(violations, fieldViolation("as_of", err))
+	}
+
+	if len(violations) > 0 {
+		return params, invalidArgumentError(violations...)
+	}
+	return params, nil
+}
+
+// validateSubscribeRatesRequest checks every requested pair of a SubscribeRatesRequest
+func validateSubscribeRatesRequest(req *pb.SubscribeRatesRequest) ([]rateParams, error) {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if len(req.GetPairs()) == 0 {
+		violations = append(violations, fieldViolation("pairs", errors.New("at least one pair is required")))
+	}
+	if len(req.GetPairs()) > maxSubscribedPairs {
+		violations = append(violations, fieldViolation("pairs", fmt.Errorf("at most %d pairs are allowed", maxSubscribedPairs)))
+	}
+
+	pairs := make([]rateParams, len(req.GetPairs()))
+	for i, pair := range req.GetPairs() {
+		var err error
+		if pairs[i].source, err = lookupCurrency(pair.GetSourceCurrency()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("pairs[%d].source_currency", i), err))
+		}
+		if pairs[i].target, err = lookupCurrency(pair.GetTargetCurrency()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("pairs[%d].target_currency", i), err))
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations...)
+	}
+	return pairs, nil
+}
+
+// listParams holds a validated ListCurrenciesRequest
+type listParams struct {
+	pageSize int
+	after    string
+	region   pb.Region
+	active   *bool
+}
+
+// validateListCurrenciesRequest checks the paging and filter fields of a ListCurrenciesRequest
+func validateListCurrenciesRequest(req *pb.ListCurrenciesRequest) (listParams, error) {
+	var params listParams
+	var violations []*errdetails.BadRequest_FieldViolation
+	var err error
+
+	if params.pageSize, err = pageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	if params.after, err = decodePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+	params.region = req.GetRegion()
+	if _, ok := pb.Region_name[int32(params.region)]; !ok {
+		violations = append(violations, fieldViolation("region", fmt.Errorf("unknown region %d", params.region)))
+	}
+	params.active = req.Active
+
+	if len(violations) > 0 {
+		return params, invalidArgumentError(violations...)
+	}
+	return params, nil
+}
+
+// maxStoredRate bounds rates to what the NUMERIC(24, 12) rate columns hold
+var maxStoredRate = decimal.New(1, 12)
+
+// ratePair resolves the currencies of an admin request, defaulting the quote currency to base
+func ratePair(baseCode, quoteCode, base, prefix string) (string, string, []*errdetails.BadRequest_FieldViolation) {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if quoteCode == "" {
+		quoteCode = base
+	}
+	if _, err := lookupCurrency(baseCode); err != nil {
+		violations = append(violations, fieldViolation(prefix+"base_currency", err))
+	}
+	if _, err := lookupCurrency(quoteCode); err != nil {
+		violations = append(violations, fieldViolation(prefix+"quote_currency", err))
+	} else if quoteCode == baseCode {
+		violations = append(violations, fieldViolation(prefix+"quote_currency", errors.New("quote_currency must differ from base_currency")))
+	}
+	return baseCode, quoteCode, violations
+}
+
+// upsertChange validates an UpsertRateRequest, naming fields with prefix, and returns the change it describes
+func upsertChange(req *pb.UpsertRateRequest, base, prefix string) (RateChange, []*errdetails.BadRequest_FieldViolation) {
+	var change RateChange
+	var violations []*errdetails.BadRequest_FieldViolation
+	change.Base, change.Quote, violations = ratePair(req.GetBaseCurrency(), req.GetQuoteCurrency(), base, prefix)
+
+	value, err := storedRate("rate", req.GetRate())
+	if err != nil {
+		violations = append(violations, fieldViolation(prefix+"rate", err))
+	}
+	change.Value = value
+
+	// Bid and ask are optional, but when published must straddle the mid rate
+	if req.GetBid() != "" {
+		bid, err := storedRate("bid", req.GetBid())
+		if err == nil && value.IsPositive() && bid.GreaterThan(value) {
+			err = errors.New("bid must not be above rate")
+		}
+		if err != nil {
+			violations = append(violations, fieldViolation(prefix+"bid", err))
+		}
+		change.Bid = bid
+	}
+	if req.GetAsk() != "" {
+		ask, err := storedRate("ask", req.GetAsk())
+		if err == nil && value.IsPositive() && ask.LessThan(value) {
+			err = errors.New("ask must not be below rate")
+		}
+		if err != nil {
+			violations = append(violations, fieldViolation(prefix+"ask", err))
+		}
+		change.Ask = ask
+	}
+
+	if req.GetEffectiveFrom() != nil {
+		if err := checkEffectiveFrom(req.GetEffectiveFrom()); err != nil {
+			violations = append(violations, fieldViolation(prefix+"effective_from", err))
+		} else {
+			change.EffectiveFrom = req.GetEffectiveFrom().AsTime()
+		}
+	}
+
+	change.Provider = req.GetProvider()
+	if change.Provider == "" {
+		change.Provider = defaultProvider
+	}
+	if len(change.Provider) > 64 {
+		violations = append(violations, fieldViolation(prefix+"provider", errors.New("provider must be at most 64 characters")))
+	}
+	return change, violations
+}
+
+// checkEffectiveFrom only accepts instants from now on, so a change can never alter the rates an
+// earlier conversion used. Leaving effective_from unset makes a change effective immediately.
+func checkEffectiveFrom(ts *timestamppb.Timestamp) error {
+	if err := ts.CheckValid(); err != nil {
+		return err
+	}
+	if ts.AsTime().Before(time.Now()) {
+		return errors.New("effective_from must not be in the past; leave it unset to take effect now")
+	}
+	return nil
+}
+
+// storedRate parses a rate that fits the NUMERIC(24, 12) rate columns
+func storedRate(name, s string) (decimal.Decimal, error) {
+	value, err := decimal.NewFromString(s)
+	switch {
+	case err != nil:
+		return value, fmt.Errorf("%s must be a decimal number: %q", name, s)
+	case !value.IsPositive():
+		return value, fmt.Errorf("%s must be positive", name)
+	case value.GreaterThanOrEqual(maxStoredRate) || !value.Equal(value.Truncate(12)):
+		return value, fmt.Errorf("%s must be below 10^12 with at most 12 decimal places", name)
+	}
+	return value, nil
+}
+
+// validateDeleteRateRequest checks a DeleteRateRequest and returns the deletion it describes
+func validateDeleteRateRequest(req *pb.DeleteRateRequest, base string) (RateChange, error) {
+	change := RateChange{Deleted: true}
+	var violations []*errdetails.BadRequest_FieldViolation
+	change.Base, change.Quote, violations = ratePair(req.GetBaseCurrency(), req.GetQuoteCurrency(), base, "")
+	if req.GetEffectiveFrom() != nil {
+		if err := checkEffectiveFrom(req.GetEffectiveFrom()); err != nil {
+			violations = append(violations, fieldViolation("effective_from", err))
+		} else {
+			change.EffectiveFrom = req.GetEffectiveFrom().AsTime()
+		}
+	}
+	if len(violations) > 0 {
+		return change, invalidArgumentError(violations...)
+	}
+	return change, nil
+}
+
+// historyParams holds a validated ListRateHistoryRequest
+type historyParams struct {
+	base     string
+	quote    string
+	pageSize int
+	before   time.Time
+}
+
+// validateListRateHistoryRequest checks the rate and paging fields of a ListRateHistoryRequest
+func validateListRateHistoryRequest(req *pb.ListRateHistoryRequest, base string) (historyParams, error) {
+	var params historyParams
+	var violations []*errdetails.BadRequest_FieldViolation
+	params.base, params.quote, violations = ratePair(req.GetBaseCurrency(), req.GetQuoteCurrency(), base, "")
+
+	var err error
+	if params.pageSize, err = pageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	after, err := decodePageToken(req.GetPageToken())
+	if err == nil && after != "" {
+		params.before, err = time.Parse(time.RFC3339Nano, after)
+	}
+	if err != nil {
+		violations = append(violations, fieldViolation("page_token", errors.New("invalid page_token")))
+	}
+
+	if len(violations) > 0 {
+		return params, invalidArgumentError(violations...)
+	}
+	return params, nil
+}
+
+// validateListConversionsRequest checks the filters and paging fields of a ListConversionsRequest
+func validateListConversionsRequest(req *pb.ListConversionsRequest) (ConversionFilter, int, error) {
+	filter := ConversionFilter{ClientID: req.GetClientId()}
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	for _, f := range []struct {
+		name, code string
+		dst        *string
+	}{
+		{"source_currency", req.GetSourceCurrency(), &filter.Source},
+		{"target_currency", req.GetTargetCurrency(), &filter.Target},
+	} {
+		if f.code == "" {
+			continue
+		}
+		if _, err := lookupCurrency(f.code); err != nil {
+			violations = append(violations, fieldViolation(f.name, err))
+		}
+		*f.dst = f.code
+	}
+
+	if ts := req.GetStartTime(); ts != nil {
+		if err := ts.CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("start_time", err))
+		} else {
+			filter.From = ts.AsTime()
+		}
+	}
+	if ts := req.GetEndTime(); ts != nil {
+		if err := ts.CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("end_time", err))
+		} else if filter.Until = ts.AsTime(); !filter.Until.After(filter.From) {
+			violations = append(violations, fieldViolation("end_time", errors.New("end_time must be after start_time")))
+		}
+	}
+
+	limit, err := pageSize(req.GetPageSize())
+	if err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	after, err := decodePageToken(req.GetPageToken())
+	if err == nil && after != "" {
+		var ok bool
+		if filter.AfterTime, filter.AfterID, ok = parseConversionCursor(after); !ok {
+			err = errors.New("invalid page_token")
+		}
+	}
+	if err != nil {
+		violations = append(violations, fieldViolation("page_token", errors.New("invalid page_token")))
+	}
+
+	if len(violations) > 0 {
+		return filter, limit, invalidArgumentError(violations...)
+	}
+	return filter, limit, nil
+}
+
+// checkAmount applies the sign and magnitude rules to a requested amount
+func (p amountPolicy) checkAmount(amount decimal.Decimal) error {
+	if amount.IsNegative() && !p.AllowNegative {
+		return errors.New("amount must not be negative")
+	}
+	if p.exceedsMax(amount) {
+		return fmt.Errorf("amount magnitude must not exceed %s", p.MaxAmount)
+	}
+	return nil
+}
+
+// checkConverted rejects conversions whose result is larger than the policy allows
+func (p amountPolicy) checkConverted(params convertParams, converted decimal.Decimal) error {
+	if p.exceedsMax(converted) {
+		return invalidArgumentError(fieldViolation(params.amountField,
+			fmt.Errorf("converted amount magnitude must not exceed %s %s", p.MaxAmount, params.target.Code)))
+	}
+	return nil
+}
+
+// requestAsOf converts an optional as_of timestamp, rejecting instants in the future
+func requestAsOf(ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, err
+	}
+	asOf := ts.AsTime()
+	if asOf.After(time.Now()) {
+		return time.Time{}, errors.New("as_of must not be in the future")
+	}
+	return asOf, nil
+}
+
+func (p amountPolicy) exceedsMax(amount decimal.Decimal) bool {
+	return !p.MaxAmount.IsZero() && amount.Abs().GreaterThan(p.MaxAmount)
+}
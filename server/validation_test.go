@@ -0,0 +1,90 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "CurrencyConverter/proto"
+)
+
+func violatedFields(t *testing.T, err error) []string {
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	var fields []string
+	for _, d := range st.Details() {
+		if badRequest, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidateRejectsNonFiniteAmounts(t *testing.T) {
+	policy := defaultAmountPolicy()
+	for _, amount := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := policy.validateConvertRequest(&pb.ConvertRequest{
+			Amount:         amount,
+			SourceCurrency: "USD",
+			TargetCurrency: "INR",
+		})
+		assert.Equal(t, []string{"amount"}, violatedFields(t, err), "%v", amount)
+	}
+}
+
+func TestValidateNegativeAmountPolicy(t *testing.T) {
+	req := &pb.ConvertRequest{Amount: -100, SourceCurrency: "USD", TargetCurrency: "INR"}
+
+	policy := defaultAmountPolicy()
+	params, err := policy.validateConvertRequest(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "-100", params.amount.String())
+
+	policy.AllowNegative = false
+	_, err = policy.validateConvertRequest(req)
+	assert.Equal(t, []string{"amount"}, violatedFields(t, err))
+}
+
+func TestValidateMaxAmount(t *testing.T) {
+	policy := amountPolicy{AllowNegative: true, MaxAmount: decimal.NewFromInt(1000)}
+
+	_, err := policy.validateConvertRequest(&pb.ConvertRequest{
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+		AmountMoney:    &pb.Money{Units: -1001},
+	})
+	assert.Equal(t, []string{"amount_money"}, violatedFields(t, err))
+
+	_, err = policy.validateConvertRequest(&pb.ConvertRequest{
+		Amount:         1000,
+		SourceCurrency: "USD",
+		TargetCurrency: "INR",
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidateReportsAllViolations(t *testing.T) {
+	_, err := defaultAmountPolicy().validateConvertRequest(&pb.ConvertRequest{
+		Amount:         math.NaN(),
+		SourceCurrency: "XYZ",
+		TargetCurrency: "ABC",
+		RoundingMode:   pb.RoundingMode(99),
+	})
+	assert.Equal(t, []string{"amount", "source_currency", "target_currency", "rounding_mode"}, violatedFields(t, err))
+}
+
+func TestCheckConvertedRejectsOverflow(t *testing.T) {
+	policy := defaultAmountPolicy()
+	params := convertParams{amountField: "amount", target: iso4217["INR"]}
+
+	err := policy.checkConverted(params, decimal.New(1, 16))
+	assert.Equal(t, []string{"amount"}, violatedFields(t, err))
+	assert.NoError(t, policy.checkConverted(params, decimal.New(1, 14)))
+}